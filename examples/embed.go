@@ -49,6 +49,23 @@ var (
 	SQLExecuteResource               = mustRead("resources/singlestoredb_sql_execute/resource.tf")
 	SQLQueryDataSource               = mustRead("data-sources/singlestoredb_sql_query/data-source.tf")
 	WorkspaceWithSQLResource         = mustRead("resources/singlestoredb_workspace_with_sql/resource.tf")
+	DataAPIReadyResource             = mustRead("resources/singlestoredb_data_api_ready/resource.tf")
+	DatabaseResource                 = mustRead("resources/singlestoredb_database/resource.tf")
+	DatabaseAttachmentResource       = mustRead("resources/singlestoredb_database_attachment/resource.tf")
+	SQLUserResource                  = mustRead("resources/singlestoredb_sql_user/resource.tf")
+	SQLGrantResource                 = mustRead("resources/singlestoredb_sql_grant/resource.tf")
+	SQLRoleResource                  = mustRead("resources/singlestoredb_sql_role/resource.tf")
+	SQLGroupResource                 = mustRead("resources/singlestoredb_sql_group/resource.tf")
+	SQLGroupMembershipResource       = mustRead("resources/singlestoredb_sql_group_membership/resource.tf")
+	PipelineResource                 = mustRead("resources/singlestoredb_pipeline/resource.tf")
+	ResourcePoolResource             = mustRead("resources/singlestoredb_resource_pool/resource.tf")
+	GlobalVariableResource           = mustRead("resources/singlestoredb_global_variable/resource.tf")
+	LinkResource                     = mustRead("resources/singlestoredb_link/resource.tf")
+	ProcedureResource                = mustRead("resources/singlestoredb_procedure/resource.tf")
+	FunctionResource                 = mustRead("resources/singlestoredb_function/resource.tf")
+	ViewResource                     = mustRead("resources/singlestoredb_view/resource.tf")
+	SQLMigrationsResource            = mustRead("resources/singlestoredb_sql_migrations/resource.tf")
+	TableResource                    = mustRead("resources/singlestoredb_table/resource.tf")
 )
 
 func mustRead(path string) string {
@@ -0,0 +1,4 @@
+import {
+  to = singlestoredb_function.with_tax
+  id = "svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/my_app_db/with_tax" // "<endpoint>/<database>/<name>"
+}
@@ -0,0 +1,4 @@
+import {
+  to = singlestoredb_global_variable.segment_rows
+  id = "svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/columnstore_segment_rows" // "<endpoint>/<name>"
+}
@@ -0,0 +1,3 @@
+# The password of the admin user is read from SINGLESTORE_SQL_USER_PASSWORD until the configuration provides it.
+# Imported variables have no prior_value, so the 'destroy' action restores the engine default.
+terraform import singlestoredb_global_variable.segment_rows svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/columnstore_segment_rows
@@ -0,0 +1,48 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_workspace_group" "example" {
+  name            = "group"
+  firewall_ranges = ["0.0.0.0/0"] // Ensure restrictive ranges for production environments.
+  expires_at      = "2222-01-01T00:00:00Z"
+  cloud_provider  = "AWS"
+  region_name     = "us-east-1"
+  admin_password  = "mockPassword193!"
+}
+
+resource "singlestoredb_workspace" "this" {
+  name               = "workspace-1"
+  workspace_group_id = singlestoredb_workspace_group.example.id
+  size               = "S-00"
+  suspended          = false
+}
+
+resource "singlestoredb_data_api_ready" "this" {
+  endpoint = singlestoredb_workspace.this.endpoint
+  username = "admin"
+  password = singlestoredb_workspace_group.example.admin_password
+}
+
+resource "singlestoredb_global_variable" "segment_rows" {
+  depends_on = [singlestoredb_data_api_ready.this]
+
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+
+  name  = "columnstore_segment_rows"
+  value = "2048000"
+}
+
+resource "singlestoredb_global_variable" "partitions" {
+  depends_on = [singlestoredb_data_api_ready.this]
+
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+
+  name       = "default_partitions_per_leaf"
+  value      = "4"
+  on_destroy = "DEFAULT" // Restore the engine default instead of the value before the first apply.
+}
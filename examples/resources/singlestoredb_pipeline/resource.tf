@@ -0,0 +1,76 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_workspace_group" "example" {
+  name            = "group"
+  firewall_ranges = ["0.0.0.0/0"] // Ensure restrictive ranges for production environments.
+  expires_at      = "2222-01-01T00:00:00Z"
+  cloud_provider  = "AWS"
+  region_name     = "us-east-1"
+  admin_password  = "mockPassword193!"
+}
+
+resource "singlestoredb_workspace" "this" {
+  name               = "workspace-1"
+  workspace_group_id = singlestoredb_workspace_group.example.id
+  size               = "S-00"
+  suspended          = false
+}
+
+resource "singlestoredb_data_api_ready" "this" {
+  endpoint = singlestoredb_workspace.this.endpoint
+  username = "admin"
+  password = singlestoredb_workspace_group.example.admin_password
+}
+
+resource "singlestoredb_database" "this" {
+  depends_on = [singlestoredb_data_api_ready.this]
+
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+
+  name = "my_app_db"
+}
+
+resource "singlestoredb_sql_execute" "orders" {
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+  database = singlestoredb_database.this.name
+
+  execute = "CREATE TABLE IF NOT EXISTS orders (id BIGINT, amount DECIMAL(10, 2), SHARD KEY (id))"
+  revert  = "DROP TABLE IF EXISTS orders"
+}
+
+resource "singlestoredb_pipeline" "this" {
+  depends_on = [singlestoredb_sql_execute.orders]
+
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+  database = singlestoredb_database.this.name
+
+  name         = "orders"
+  source_type  = "S3"
+  source       = "singlestore-example-datasets/orders/"
+  into_table   = "orders"
+  load_options = "FIELDS TERMINATED BY ','"
+
+  // Bump the versions to apply changes of the write-only config and credentials.
+  config              = jsonencode({ region = "us-east-1" })
+  config_version      = 1
+  credentials         = jsonencode({}) // A public bucket; pass the access keys for private buckets.
+  credentials_version = 1
+
+  batch_interval = 2500
+  running        = true
+}
+
+output "pipeline_state" {
+  value = singlestoredb_pipeline.this.state
+}
+
+output "pipeline_errors" {
+  value = singlestoredb_pipeline.this.errors
+}
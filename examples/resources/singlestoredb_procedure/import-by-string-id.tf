@@ -0,0 +1,4 @@
+import {
+  to = singlestoredb_procedure.delete_order
+  id = "svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/my_app_db/delete_order" // "<endpoint>/<database>/<name>"
+}
@@ -0,0 +1,2 @@
+# The password of the admin user is read from SINGLESTORE_SQL_USER_PASSWORD until the configuration provides it.
+terraform import singlestoredb_resource_pool.bi svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/bi
@@ -0,0 +1,9 @@
+import {
+  to = singlestoredb_sql_group_membership.reader
+  id = "svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/analysts/role:reader" // "<endpoint>/<group>/role:<role>"
+}
+
+import {
+  to = singlestoredb_sql_group_membership.app
+  id = "svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/analysts/app@%" // "<endpoint>/<group>/<user>@<host>"
+}
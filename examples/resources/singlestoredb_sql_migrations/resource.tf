@@ -0,0 +1,74 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_workspace_group" "example" {
+  name            = "group"
+  firewall_ranges = ["0.0.0.0/0"] // Ensure restrictive ranges for production environments.
+  expires_at      = "2222-01-01T00:00:00Z"
+  cloud_provider  = "AWS"
+  region_name     = "us-east-1"
+  admin_password  = "mockPassword193!"
+}
+
+resource "singlestoredb_workspace" "this" {
+  name               = "workspace-1"
+  workspace_group_id = singlestoredb_workspace_group.example.id
+  size               = "S-00"
+  suspended          = false
+}
+
+resource "singlestoredb_data_api_ready" "this" {
+  endpoint = singlestoredb_workspace.this.endpoint
+  username = "admin"
+  password = singlestoredb_workspace_group.example.admin_password
+}
+
+resource "singlestoredb_database" "this" {
+  depends_on = [singlestoredb_data_api_ready.this]
+
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+
+  name = "my_app_db"
+}
+
+// The migrations usually live in files, e.g., migrations/0001.up.sql and migrations/0001.down.sql:
+//
+//   migrations = [
+//     for f in sort(fileset("${path.module}/migrations", "*.up.sql")) : {
+//       version = trimsuffix(f, ".up.sql")
+//       up      = file("${path.module}/migrations/${f}")
+//       down    = try(file("${path.module}/migrations/${trimsuffix(f, ".up.sql")}.down.sql"), null)
+//     }
+//   ]
+resource "singlestoredb_sql_migrations" "this" {
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+  database = singlestoredb_database.this.name
+
+  migrations = [
+    {
+      version = "0001"
+      up      = <<-SQL
+        CREATE TABLE orders (order_id BIGINT, amount DECIMAL(10, 2), SHARD KEY (order_id));
+        CREATE TABLE customers (customer_id BIGINT, name TEXT, SHARD KEY (customer_id));
+      SQL
+      down    = <<-SQL
+        DROP TABLE customers;
+        DROP TABLE orders;
+      SQL
+    },
+    {
+      version = "0002"
+      up      = "ALTER TABLE orders ADD COLUMN customer_id BIGINT"
+      down    = "ALTER TABLE orders DROP COLUMN customer_id"
+    },
+  ]
+}
+
+output "applied_versions" {
+  value = singlestoredb_sql_migrations.this.applied_versions
+}
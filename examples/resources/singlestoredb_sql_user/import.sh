@@ -0,0 +1,3 @@
+# The password of the admin user is read from SINGLESTORE_SQL_USER_PASSWORD until the configuration provides it.
+# The password of the imported user cannot be read back; it is set on the next apply if the configuration provides it.
+terraform import singlestoredb_sql_user.app svc-3c0c0d99-3c09-45ac-a01f-5ab62afd35cf-dml.aws-virginia-5.svc.singlestore.com/app@%
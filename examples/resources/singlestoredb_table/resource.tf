@@ -0,0 +1,63 @@
+provider "singlestoredb" {
+  // The SingleStoreDB Terraform provider uses the SINGLESTOREDB_API_KEY environment variable for authentication.
+  // Please set this environment variable with your SingleStore Management API key.
+  // You can generate this key from the SingleStore Portal at https://portal.singlestore.com/organizations/org-id/api-keys.
+}
+
+resource "singlestoredb_workspace_group" "example" {
+  name            = "group"
+  firewall_ranges = ["0.0.0.0/0"] // Ensure restrictive ranges for production environments.
+  expires_at      = "2222-01-01T00:00:00Z"
+  cloud_provider  = "AWS"
+  region_name     = "us-east-1"
+  admin_password  = "mockPassword193!"
+}
+
+resource "singlestoredb_workspace" "this" {
+  name               = "workspace-1"
+  workspace_group_id = singlestoredb_workspace_group.example.id
+  size               = "S-00"
+  suspended          = false
+}
+
+resource "singlestoredb_data_api_ready" "this" {
+  endpoint = singlestoredb_workspace.this.endpoint
+  username = "admin"
+  password = singlestoredb_workspace_group.example.admin_password
+}
+
+resource "singlestoredb_database" "this" {
+  depends_on = [singlestoredb_data_api_ready.this]
+
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+
+  name = "my_app_db"
+}
+
+resource "singlestoredb_table" "orders" {
+  endpoint = singlestoredb_workspace.this.endpoint
+  password = singlestoredb_workspace_group.example.admin_password
+  database = singlestoredb_database.this.name
+
+  name = "orders"
+
+  columns = [
+    { name = "order_id", type = "BIGINT", nullable = false },
+    { name = "customer_id", type = "BIGINT", nullable = false },
+    { name = "status", type = "VARCHAR(32)", nullable = false, default = "'pending'" },
+    { name = "amount", type = "DECIMAL(10, 2)" },
+    { name = "notes", type = "TEXT" },
+    { name = "embedding", type = "VECTOR(4)" },
+    { name = "created_at", type = "DATETIME(6)", nullable = false, default = "CURRENT_TIMESTAMP(6)" },
+  ]
+
+  shard_key = ["order_id"]
+  sort_key  = ["created_at"]
+
+  indexes = [
+    { name = "customer_idx", columns = ["customer_id"] },
+    { name = "notes_ft", type = "FULLTEXT", columns = ["notes"] },
+    { name = "embedding_idx", type = "VECTOR", columns = ["embedding"], options = "INDEX_OPTIONS '{\"index_type\": \"AUTO\", \"metric_type\": \"DOT_PRODUCT\"}'" },
+  ]
+}
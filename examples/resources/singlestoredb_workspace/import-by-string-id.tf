@@ -7,3 +7,9 @@ import {
   to = singlestoredb_workspace.this
   id = "01ede7ad-6e5e-43f2-80e6-f1139aebc47a"
 }
+
+// The workspace may also be imported by name ("<workspace group name>/<workspace name>").
+// import {
+//   to = singlestoredb_workspace.this
+//   id = "group/workspace-1"
+// }
@@ -1,2 +1,5 @@
 terraform import singlestoredb_workspace_group.example 3c0c0d99-3c09-45ac-a01f-5ab62afd35cf
 terraform import singlestoredb_workspace.this 01ede7ad-6e5e-43f2-80e6-f1139aebc47a
+
+# Alternatively, import the workspace by workspace group name and workspace name.
+terraform import singlestoredb_workspace.this group/workspace-1
@@ -1,4 +1,11 @@
 import {
   to = singlestoredb_workspace_group.this
   id = "3c0c0d99-3c09-45ac-a01f-5ab62afd35cf"
-}
\ No newline at end of file
+}
+
+// The workspace group may also be imported by name ("name:<workspace group name>")
+// or by project and name ("<project name>/<workspace group name>").
+// import {
+//   to = singlestoredb_workspace_group.this
+//   id = "my-project/group"
+// }
@@ -1 +1,5 @@
-terraform import singlestoredb_workspace_group.this 3c0c0d99-3c09-45ac-a01f-5ab62afd35cf
\ No newline at end of file
+terraform import singlestoredb_workspace_group.this 3c0c0d99-3c09-45ac-a01f-5ab62afd35cf
+
+# Alternatively, import by name or by project and name.
+terraform import singlestoredb_workspace_group.this name:group
+terraform import singlestoredb_workspace_group.this my-project/group
@@ -50,8 +50,21 @@ locals {
   reader_workspace_name = singlestoredb_workspace.reader.name
 }
 
+// The Data API may lag behind the workspaces becoming active, so the SQL resources depend on these gates.
+resource "singlestoredb_data_api_ready" "this" {
+  endpoint = local.sql_endpoint
+  username = local.sql_username
+  password = local.sql_password
+}
+
+resource "singlestoredb_data_api_ready" "reader" {
+  endpoint = local.reader_sql_endpoint
+  username = local.sql_username
+  password = local.sql_password
+}
+
 resource "singlestoredb_sql_execute" "create_db" {
-  depends_on = [singlestoredb_workspace.this]
+  depends_on = [singlestoredb_data_api_ready.this]
 
   endpoint = local.sql_endpoint
   username = local.sql_username
@@ -118,7 +131,7 @@ resource "singlestoredb_sql_execute" "grant_readonly" {
   revert  = "REVOKE SELECT ON my_app_db.* FROM 'app_readonly'@'%'"
 }
 
-resource "singlestoredb_sql_execute" "create_users_table" {
+resource "singlestoredb_sql_execute" "create_tables" {
   depends_on = [
     singlestoredb_sql_execute.grant_app_user,
     singlestoredb_sql_execute.grant_readonly,
@@ -129,38 +142,20 @@ resource "singlestoredb_sql_execute" "create_users_table" {
   password = local.sql_password
   database = local.app_db
 
-  execute = <<-EOT
-    CREATE TABLE IF NOT EXISTS users (
-      id INT AUTO_INCREMENT PRIMARY KEY,
-      email VARCHAR(100) NOT NULL,
-      password VARCHAR(100) NOT NULL
-    )
-  EOT
-  revert  = "DROP TABLE IF EXISTS users"
-}
-
-resource "singlestoredb_sql_execute" "create_posts_table" {
-  depends_on = [singlestoredb_sql_execute.create_users_table]
-
-  endpoint = local.sql_endpoint
-  username = local.sql_username
-  password = local.sql_password
-  database = local.app_db
-
-  execute = <<-EOT
-    CREATE TABLE IF NOT EXISTS posts (
-      id INT AUTO_INCREMENT PRIMARY KEY,
-      user_id INT NOT NULL,
-      title VARCHAR(200),
-      body TEXT
-    )
-  EOT
-  revert  = "DROP TABLE IF EXISTS posts"
+  // The statements run in order; the revert statements undo them in reverse order.
+  execute = [
+    "CREATE TABLE IF NOT EXISTS users (id INT AUTO_INCREMENT PRIMARY KEY, email VARCHAR(100) NOT NULL, password VARCHAR(100) NOT NULL)",
+    "CREATE TABLE IF NOT EXISTS posts (id INT AUTO_INCREMENT PRIMARY KEY, user_id INT NOT NULL, title VARCHAR(200), body TEXT)",
+  ]
+  revert = [
+    "DROP TABLE IF EXISTS users",
+    "DROP TABLE IF EXISTS posts",
+  ]
 }
 
 resource "singlestoredb_sql_execute" "attach_app_db_readonly" {
   depends_on = [
-    singlestoredb_workspace.reader,
+    singlestoredb_data_api_ready.reader,
     singlestoredb_sql_execute.create_db,
   ]
 
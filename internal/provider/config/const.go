@@ -55,6 +55,16 @@ const (
 	FlowInstanceCreationTimeout = time.Hour
 	// FlowInstanceConsistencyThreshold is the count of polling iterations where the status should consistently be Running.
 	FlowInstanceConsistencyThreshold = 5
+	// PollMinInterval is the shortest delay between two polls of the Management API while waiting for a resource.
+	PollMinInterval = 500 * time.Millisecond
+	// PollMaxInterval caps the exponential backoff between polls when nothing changes, e.g., during a multi-hour creation.
+	PollMaxInterval = 30 * time.Second
+	// PollJitter is the fraction by which each polling delay is randomly shortened or extended.
+	PollJitter = 0.2
+	// DataAPIReadyTimeout limits the wait for the Data API of a workspace to accept queries.
+	DataAPIReadyTimeout = 30 * time.Minute
+	// DataAPIReadyConsistencyThreshold is the count of consecutive successful queries that mark the Data API ready.
+	DataAPIReadyConsistencyThreshold = 3
 	// AdminPasswordMinLength is the minimum length for the admin password.
 	AdminPasswordMinLength = 14
 	// TestIDValue indicates the value of the test only ID field.
@@ -0,0 +1,293 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	AttachmentResourceName = "database_attachment"
+
+	attachmentModeReadWrite = "READ_WRITE"
+	attachmentModeReadOnly  = "READ_ONLY"
+)
+
+var (
+	_ resource.Resource              = &attachmentResource{}
+	_ resource.ResourceWithConfigure = &attachmentResource{}
+)
+
+type attachmentResourceModel struct {
+	sql.ConnectionModel
+	ID            types.String `tfsdk:"id"`
+	Database      types.String `tfsdk:"database"`
+	WorkspaceName types.String `tfsdk:"workspace_name"`
+	Mode          types.String `tfsdk:"mode"`
+}
+
+type attachmentResource struct {
+	sql.Connector
+}
+
+func NewAttachmentResource() resource.Resource {
+	return &attachmentResource{}
+}
+
+func (r *attachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, AttachmentResourceName)
+}
+
+func (r *attachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Attach a database to a SingleStore Helios workspace via the Data API. " +
+			"A database can be attached read-write to one workspace and read-only to any number of other workspaces " +
+			"in the same workspace group, e.g., to split transactional and analytical load. " +
+			"The 'apply' action runs `ATTACH DATABASE` on the target workspace, and the 'destroy' action runs `DETACH DATABASE`. " +
+			"A database detached outside of Terraform, or attached with another mode, is attached again on the next apply.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the attachment in the form `<endpoint>/<database>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the database to attach. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"workspace_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the target workspace, i.e., the workspace of `endpoint`. Typically `singlestoredb_workspace.<n>.name`. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: fmt.Sprintf("The attachment mode, either `%s` or `%s`. A database can be attached `%s` to at most one workspace at a time. Changing this value forces replacement, which detaches and attaches the database again.",
+					attachmentModeReadWrite, attachmentModeReadOnly, attachmentModeReadWrite,
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(attachmentModeReadWrite, attachmentModeReadOnly),
+				},
+			},
+		}),
+	}
+}
+
+func (r *attachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan attachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: attachStatement(plan)}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	mode, err := attachedMode(ctx, client, plan.Database.ValueString())
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if mode == "" {
+		resp.Diagnostics.AddError(
+			"Database not attached",
+			fmt.Sprintf("The database %s is not listed in the workspace %s after it was attached. %s",
+				plan.Database.ValueString(), plan.WorkspaceName.ValueString(), config.CreateProviderIssueErrorDetail,
+			),
+		)
+
+		return
+	}
+
+	if mode != plan.Mode.ValueString() {
+		resp.Diagnostics.AddError(
+			"Database attached with another mode",
+			fmt.Sprintf("The database %s is attached %s to the workspace %s after it was attached %s. %s",
+				plan.Database.ValueString(), mode, plan.WorkspaceName.ValueString(), plan.Mode.ValueString(), config.CreateProviderIssueErrorDetail,
+			),
+		)
+
+		return
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Database.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *attachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state attachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	mode, err := attachedMode(ctx, client, state.Database.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if mode == "" {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// A database attached with another mode outside of Terraform is replaced.
+	state.Mode = types.StringValue(mode)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *attachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan attachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the credentials change in place; they do not affect the attachment.
+	plan.ConnectionModel = plan.ForState()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *attachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state attachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	mode, err := attachedMode(ctx, client, state.Database.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if mode == "" {
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: detachStatement(state)}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *attachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func attachStatement(model attachmentResourceModel) string {
+	statement := "ATTACH DATABASE " + sql.QuoteIdentifier(model.Database.ValueString())
+	if model.Mode.ValueString() == attachmentModeReadOnly {
+		statement += " READ ONLY"
+	}
+
+	return statement
+}
+
+func detachStatement(model attachmentResourceModel) string {
+	return fmt.Sprintf("DETACH DATABASE %s FROM WORKSPACE %s",
+		sql.QuoteIdentifier(model.Database.ValueString()), sql.QuoteIdentifier(model.WorkspaceName.ValueString()),
+	)
+}
+
+// attachedMode returns the mode the database is attached with to the workspace of the client,
+// or an empty string if it is not attached. SHOW DATABASES EXTENDED reports the role of each database:
+// the workspace that attached the database read-write holds the master, and the others hold replicas.
+func attachedMode(ctx context.Context, client *sql.Client, database string) (string, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  "SHOW DATABASES EXTENDED LIKE ?",
+		Args: []any{database},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// LIKE treats '_' and '%' as wildcards, so the names are compared exactly.
+	for _, row := range rows {
+		if databaseName(row) != database {
+			continue
+		}
+
+		if strings.EqualFold(row["Role"], "master") {
+			return attachmentModeReadWrite, nil
+		}
+
+		return attachmentModeReadOnly, nil
+	}
+
+	return "", nil
+}
+
+// databaseName returns the name of the database of a SHOW DATABASES row,
+// whose column is named `Database` or `Database (<pattern>)`.
+func databaseName(row map[string]string) string {
+	for column, value := range row {
+		if column == "Database" || strings.HasPrefix(column, "Database (") {
+			return value
+		}
+	}
+
+	return ""
+}
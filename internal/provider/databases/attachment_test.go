@@ -0,0 +1,177 @@
+package databases_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// fakeReaderWorkspace keeps the roles of the databases attached to a mock workspace.
+type fakeReaderWorkspace struct {
+	*testutil.FakeWorkspace
+	roles map[string]string
+}
+
+func newFakeReaderWorkspace(t *testing.T) *fakeReaderWorkspace {
+	t.Helper()
+
+	w := &fakeReaderWorkspace{roles: map[string]string{}}
+
+	attach := func(role string) func(req testutil.DataAPIRequest, _ []string) error {
+		return func(req testutil.DataAPIRequest, _ []string) error {
+			w.Record(req.SQL)
+			w.roles["my_app_db"] = role
+
+			return nil
+		}
+	}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{Pattern: testutil.Exactly("ATTACH DATABASE `my_app_db`"), Exec: attach("master")},
+		{Pattern: testutil.Exactly("ATTACH DATABASE `my_app_db` READ ONLY"), Exec: attach("async replica")},
+		{
+			Pattern: testutil.Exactly("DETACH DATABASE `my_app_db` FROM WORKSPACE `workspace-2`"),
+			Exec: func(req testutil.DataAPIRequest, _ []string) error {
+				w.Record(req.SQL)
+				delete(w.roles, "my_app_db")
+
+				return nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("SHOW DATABASES EXTENDED LIKE ?"),
+			Query: func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				rows := []map[string]any{}
+				if role, ok := w.roles["my_app_db"]; ok {
+					rows = append(rows, map[string]any{"Database (my_app_db)": "my_app_db", "Role": role})
+				}
+
+				// LIKE matches my_app_db to myXappXdb as well.
+				rows = append(rows, map[string]any{"Database (my_app_db)": "myXappXdb", "Role": "master"})
+
+				return rows, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeReaderWorkspace) setOutsideTerraform(database, role string) {
+	w.Do(func() {
+		if role == "" {
+			delete(w.roles, database)
+		} else {
+			w.roles[database] = role
+		}
+	})
+}
+
+func (w *fakeReaderWorkspace) isAttached(database string) bool {
+	var attached bool
+	w.Do(func() {
+		_, attached = w.roles[database]
+	})
+
+	return attached
+}
+
+func attachmentConfig(mode string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_database_attachment" "this" {
+  endpoint       = %q
+  password       = "secret"
+  database       = "my_app_db"
+  workspace_name = "workspace-2"
+  mode           = %q
+}
+`, testutil.TestWorkspaceEndpoint, mode)
+}
+
+func TestCRUDDatabaseAttachment(t *testing.T) {
+	w := newFakeReaderWorkspace(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: attachmentConfig("READ_ONLY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database_attachment.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/my_app_db"),
+					resource.TestCheckResourceAttr("singlestoredb_database_attachment.this", "mode", "READ_ONLY"),
+				),
+			},
+			{
+				// The database detached outside of Terraform is attached again.
+				PreConfig: func() {
+					w.setOutsideTerraform("my_app_db", "")
+				},
+				Config: attachmentConfig("READ_ONLY"),
+				Check: func(_ *terraform.State) error {
+					if !w.isAttached("my_app_db") {
+						return fmt.Errorf("the database should be attached again")
+					}
+
+					return nil
+				},
+			},
+			{
+				// Changing the mode attaches the database again.
+				Config: attachmentConfig("READ_WRITE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database_attachment.this", "mode", "READ_WRITE"),
+				),
+			},
+			{
+				// The database attached with another mode outside of Terraform is attached again.
+				PreConfig: func() {
+					w.setOutsideTerraform("my_app_db", "async replica")
+				},
+				Config: attachmentConfig("READ_WRITE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database_attachment.this", "mode", "READ_WRITE"),
+				),
+			},
+		},
+	})
+
+	require.False(t, w.isAttached("my_app_db"), "destroy should detach the database")
+	require.Equal(t, []string{
+		"ATTACH DATABASE `my_app_db` READ ONLY",
+		"ATTACH DATABASE `my_app_db` READ ONLY",
+		"DETACH DATABASE `my_app_db` FROM WORKSPACE `workspace-2`",
+		"ATTACH DATABASE `my_app_db`",
+		"DETACH DATABASE `my_app_db` FROM WORKSPACE `workspace-2`",
+		"ATTACH DATABASE `my_app_db`",
+		"DETACH DATABASE `my_app_db` FROM WORKSPACE `workspace-2`",
+	}, w.Executed())
+}
+
+func TestDatabaseAttachmentIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.DatabaseAttachmentResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database_attachment.reader", "database", "my_app_db"),
+					resource.TestCheckResourceAttr("singlestoredb_database_attachment.reader", "workspace_name", config.TestReaderWorkspaceName),
+				),
+			},
+		},
+	})
+}
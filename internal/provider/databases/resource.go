@@ -0,0 +1,354 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "database"
+)
+
+var (
+	_ resource.Resource                = &databaseResource{}
+	_ resource.ResourceWithConfigure   = &databaseResource{}
+	_ resource.ResourceWithImportState = &databaseResource{}
+)
+
+type databaseResourceModel struct {
+	sql.ConnectionModel
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Partitions      types.Int64  `tfsdk:"partitions"`
+	SyncReplication types.Bool   `tfsdk:"sync_replication"`
+	SyncDurability  types.Bool   `tfsdk:"sync_durability"`
+	ForceDestroy    types.Bool   `tfsdk:"force_destroy"`
+}
+
+// databaseInfo is a row of information_schema.DISTRIBUTED_DATABASES.
+type databaseInfo struct {
+	Name            string
+	Partitions      int64
+	SyncReplication bool
+	SyncDurability  bool
+}
+
+type databaseResource struct {
+	sql.Connector
+}
+
+func NewResource() resource.Resource {
+	return &databaseResource{}
+}
+
+func (r *databaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+func (r *databaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a database in a SingleStore Helios workspace via the Data API or the MySQL protocol. " +
+			"The 'apply' action creates the database, and the 'destroy' action drops it unless it contains tables. " +
+			"Changes made outside of Terraform are detected through `information_schema.DISTRIBUTED_DATABASES`. " +
+			"Requires HTTPS access to the workspace host on port 443, or access on port 3306 with `protocol = \"mysql\"`.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the database in the form `<endpoint>/<name>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the database. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"partitions": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The number of partitions of the database. Defaults to the workspace setting. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"sync_replication": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether the database replicates synchronously (`WITH SYNC REPLICATION`). Defaults to the workspace setting. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_durability": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether writes are durable on disk before they are acknowledged (`WITH SYNC DURABILITY`). Defaults to the workspace setting. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the 'destroy' action drops the database even if it contains tables. Defaults to `false`, which protects the data from being dropped by accident.",
+			},
+		}),
+	}
+}
+
+func (r *databaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: createDatabaseStatement(plan)}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	info, err := readDatabase(ctx, client, plan.Name.ValueString())
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if info == nil {
+		resp.Diagnostics.AddError(
+			"Database not found after creation",
+			fmt.Sprintf("The database %s is not listed in information_schema.DISTRIBUTED_DATABASES after it was created. %s",
+				plan.Name.ValueString(), config.CreateProviderIssueErrorDetail,
+			),
+		)
+
+		return
+	}
+
+	result := toDatabaseResourceModel(plan, *info)
+	result.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state databaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	info, err := readDatabase(ctx, client, state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if info == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	result := toDatabaseResourceModel(state, *info)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *databaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan databaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the credentials and force_destroy change in place; they do not affect the database.
+	plan.ConnectionModel = plan.ForState()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state databaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	if !state.ForceDestroy.ValueBool() {
+		tables, err := countTables(ctx, client, name)
+		if err != nil {
+			sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+			return
+		}
+
+		if tables > 0 {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Database %s is not empty", name),
+				fmt.Sprintf("The database %s contains %d tables. To drop the database with its data, set force_destroy to true and apply before destroying it.", name, tables),
+			)
+
+			return
+		}
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: "DROP DATABASE IF EXISTS " + sql.QuoteIdentifier(name)}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := sql.ImportConnection(ctx, req, resp, "`<endpoint>/<database>`", 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), names[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
+}
+
+func createDatabaseStatement(plan databaseResourceModel) string {
+	statement := []string{"CREATE DATABASE", sql.QuoteIdentifier(plan.Name.ValueString())}
+
+	if sync := util.MaybeBool(plan.SyncDurability); sync != nil {
+		statement = append(statement, "WITH", syncOption(*sync), "DURABILITY")
+	}
+
+	if sync := util.MaybeBool(plan.SyncReplication); sync != nil {
+		statement = append(statement, "WITH", syncOption(*sync), "REPLICATION")
+	}
+
+	if partitions := util.MaybeInt64(plan.Partitions); partitions != nil {
+		statement = append(statement, "PARTITIONS", strconv.FormatInt(*partitions, 10))
+	}
+
+	return strings.Join(statement, " ")
+}
+
+func syncOption(sync bool) string {
+	if sync {
+		return "SYNC"
+	}
+
+	return "ASYNC"
+}
+
+// readDatabase returns nil if the database does not exist.
+func readDatabase(ctx context.Context, client *sql.Client, name string) (*databaseInfo, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL: "SELECT DATABASE_NAME, NUM_PARTITIONS, IS_SYNC, IS_SYNC_DURABILITY " +
+			"FROM information_schema.DISTRIBUTED_DATABASES WHERE DATABASE_NAME = ?",
+		Args: []any{name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	partitions, err := strconv.ParseInt(rows[0]["NUM_PARTITIONS"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NUM_PARTITIONS of the database %s: %w", name, err)
+	}
+
+	return &databaseInfo{
+		Name:            rows[0]["DATABASE_NAME"],
+		Partitions:      partitions,
+		SyncReplication: sql.IsTrue(rows[0]["IS_SYNC"]),
+		SyncDurability:  sql.IsTrue(rows[0]["IS_SYNC_DURABILITY"]),
+	}, nil
+}
+
+func countTables(ctx context.Context, client *sql.Client, database string) (int64, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  "SELECT COUNT(*) AS TABLE_COUNT FROM information_schema.TABLES WHERE TABLE_SCHEMA = ?",
+		Args: []any{database},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(rows[0]["TABLE_COUNT"], 10, 64)
+}
+
+func toDatabaseResourceModel(model databaseResourceModel, info databaseInfo) databaseResourceModel {
+	model.ConnectionModel = model.ForState()
+	model.Name = types.StringValue(info.Name)
+	model.Partitions = types.Int64Value(info.Partitions)
+	model.SyncReplication = types.BoolValue(info.SyncReplication)
+	model.SyncDurability = types.BoolValue(info.SyncDurability)
+
+	return model
+}
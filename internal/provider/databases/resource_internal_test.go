@@ -0,0 +1,36 @@
+package databases
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDatabaseStatement(t *testing.T) {
+	model := databaseResourceModel{
+		Name:            types.StringValue("my`db"),
+		Partitions:      types.Int64Unknown(),
+		SyncReplication: types.BoolUnknown(),
+		SyncDurability:  types.BoolNull(),
+	}
+	require.Equal(t, "CREATE DATABASE `my``db`", createDatabaseStatement(model))
+
+	model.Partitions = types.Int64Value(16)
+	model.SyncReplication = types.BoolValue(false)
+	model.SyncDurability = types.BoolValue(true)
+	require.Equal(t, "CREATE DATABASE `my``db` WITH SYNC DURABILITY WITH ASYNC REPLICATION PARTITIONS 16", createDatabaseStatement(model))
+}
+
+func TestAttachmentStatements(t *testing.T) {
+	model := attachmentResourceModel{
+		Database:      types.StringValue("my_app_db"),
+		WorkspaceName: types.StringValue("workspace-2"),
+		Mode:          types.StringValue(attachmentModeReadOnly),
+	}
+	require.Equal(t, "ATTACH DATABASE `my_app_db` READ ONLY", attachStatement(model))
+	require.Equal(t, "DETACH DATABASE `my_app_db` FROM WORKSPACE `workspace-2`", detachStatement(model))
+
+	model.Mode = types.StringValue(attachmentModeReadWrite)
+	require.Equal(t, "ATTACH DATABASE `my_app_db`", attachStatement(model))
+}
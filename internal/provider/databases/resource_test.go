@@ -0,0 +1,234 @@
+package databases_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var createDatabaseRegexp = regexp.MustCompile("^CREATE DATABASE `([^`]+)`(.*)$")
+
+// fakeWorkspace keeps the databases and the table counts of a mock workspace.
+type fakeWorkspace struct {
+	*testutil.FakeWorkspace
+	databases map[string]string
+	tables    map[string]int
+}
+
+func newFakeWorkspace(t *testing.T) *fakeWorkspace {
+	t.Helper()
+
+	w := &fakeWorkspace{databases: map[string]string{}, tables: map[string]int{}}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: createDatabaseRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				w.Record(req.SQL)
+
+				if _, ok := w.databases[m[1]]; ok {
+					return fmt.Errorf("Can't create database '%s'; database exists", m[1])
+				}
+
+				partitions := "8"
+				if p := regexp.MustCompile(`PARTITIONS (\d+)`).FindStringSubmatch(m[2]); p != nil {
+					partitions = p[1]
+				}
+
+				w.databases[m[1]] = partitions
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile("^DROP DATABASE IF EXISTS `([^`]+)`$"),
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				w.Record(req.SQL)
+				delete(w.databases, m[1])
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile("DISTRIBUTED_DATABASES"),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				name := req.Args[0].(string)
+
+				partitions, ok := w.databases[name]
+				if !ok {
+					return nil, nil
+				}
+
+				return []map[string]any{{
+					"DATABASE_NAME":      name,
+					"NUM_PARTITIONS":     partitions,
+					"IS_SYNC":            1,
+					"IS_SYNC_DURABILITY": 0,
+				}}, nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile("information_schema.TABLES"),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				return []map[string]any{{"TABLE_COUNT": w.tables[req.Args[0].(string)]}}, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeWorkspace) setTables(database string, count int) {
+	w.Do(func() {
+		w.tables[database] = count
+	})
+}
+
+func (w *fakeWorkspace) dropOutsideTerraform(database string) {
+	w.Do(func() {
+		delete(w.databases, database)
+	})
+}
+
+func (w *fakeWorkspace) hasDatabase(database string) bool {
+	var ok bool
+	w.Do(func() {
+		_, ok = w.databases[database]
+	})
+
+	return ok
+}
+
+func databaseConfig(extra string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_database" "this" {
+  endpoint   = %q
+  password   = "secret"
+  name       = "my_app_db"
+  partitions = 4
+  %s
+}
+`, testutil.TestWorkspaceEndpoint, extra)
+}
+
+func TestCRUDDatabase(t *testing.T) {
+	w := newFakeWorkspace(t)
+	t.Setenv(config.EnvSQLUserPassword, "secret") // The imported database reads the password from the environment.
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/my_app_db"),
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "username", "admin"),
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "partitions", "4"),
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "sync_replication", "true"),
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "sync_durability", "false"),
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "force_destroy", "false"),
+				),
+			},
+			{
+				ResourceName:            "singlestoredb_database.this",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/my_app_db",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				// The database dropped outside of Terraform is created again.
+				PreConfig: func() {
+					w.dropOutsideTerraform("my_app_db")
+				},
+				Config: databaseConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "name", "my_app_db"),
+				),
+			},
+		},
+	})
+
+	require.False(t, w.hasDatabase("my_app_db"), "destroy should drop the database")
+	require.Contains(t, w.Executed(), "CREATE DATABASE `my_app_db` PARTITIONS 4")
+}
+
+func TestDestroyNonEmptyDatabase(t *testing.T) {
+	w := newFakeWorkspace(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: databaseConfig(""),
+				Check: func(_ *terraform.State) error {
+					w.setTables("my_app_db", 2)
+
+					return nil
+				},
+			},
+			{
+				Config:      databaseConfig(""),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Database my_app_db is not empty"),
+			},
+			{
+				// Setting force_destroy in place allows dropping the database.
+				Config: databaseConfig("force_destroy = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+
+	require.False(t, w.hasDatabase("my_app_db"), "destroy with force_destroy should drop the database")
+}
+
+func TestImportDatabaseInvalidID(t *testing.T) {
+	newFakeWorkspace(t)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIKey: testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:        databaseConfig(""),
+				ResourceName:  "singlestoredb_database.this",
+				ImportState:   true,
+				ImportStateId: "my_app_db",
+				ExpectError:   regexp.MustCompile("Invalid import ID"),
+			},
+		},
+	})
+}
+
+func TestCRUDDatabaseIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.DatabaseResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "name", "my_app_db"),
+					resource.TestCheckResourceAttr("singlestoredb_database.this", "partitions", "8"),
+					resource.TestCheckResourceAttrSet("singlestoredb_database.this", "sync_replication"),
+				),
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package flow
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -123,6 +124,17 @@ func (r *flowInstanceResource) Create(ctx context.Context, req resource.CreateRe
 		Size:         util.Ptr(plan.Size.ValueString()),
 	}
 
+	unlock, lerr := lockWorkspaceGroup(ctx, r.ClientWithResponsesInterface, plan.WorkspaceID, fmt.Sprintf("Creating the Flow instance %s", plan.Name.ValueString()))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
+
 	flowCreateResponse, err := r.PostV1FlowWithResponse(ctx, createBody)
 	if serr := util.StatusOK(flowCreateResponse, err); serr != nil {
 		resp.Diagnostics.AddError(
@@ -134,15 +146,16 @@ func (r *flowInstanceResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	flowID := flowCreateResponse.JSON200.FlowID
+	resp.Diagnostics.Append(util.SavePendingCreate(ctx, req, resp, flowID.String())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	flow, werr := wait(ctx, r.ClientWithResponsesInterface, flowID, config.FlowInstanceCreationTimeout,
 		waitConditionReady(),
 	)
 	if werr != nil {
-		resp.Diagnostics.AddError(
-			werr.Summary,
-			werr.Detail,
-		)
+		util.AddCreateWaitDiagnostic(ctx, &resp.Diagnostics, "Flow instance", flowID.String(), werr)
 
 		return
 	}
@@ -152,6 +165,7 @@ func (r *flowInstanceResource) Create(ctx context.Context, req resource.CreateRe
 	result.DatabaseName = plan.DatabaseName
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -189,7 +203,38 @@ func (r *flowInstanceResource) Read(ctx context.Context, req resource.ReadReques
 // Update updates the resource and sets the updated Terraform state on success.
 // Since Flow instances are immutable, all changes require replacement.
 // The `ModifyUpdate` method is enforcing this restriction.
+// The only update is waiting for a Flow instance whose creation was interrupted.
 func (r *flowInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if util.IsCreatePending(ctx, req.Private) {
+		var plan flowInstanceResourceModel
+		diags := req.Plan.Get(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		flow, werr := wait(ctx, r.ClientWithResponsesInterface, uuid.MustParse(plan.ID.ValueString()), config.FlowInstanceCreationTimeout,
+			waitConditionReady(),
+		)
+		if werr != nil {
+			resp.Diagnostics.AddError(
+				werr.Summary,
+				werr.Detail,
+			)
+
+			return
+		}
+
+		result := toFlowInstanceResourceModel(flow, nil)
+		result.UserName = plan.UserName
+		result.DatabaseName = plan.DatabaseName
+		diags = resp.State.Set(ctx, &result)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
+
+		return
+	}
+
 	resp.Diagnostics.AddError(
 		"Update not supported",
 		"Flow instances are immutable. Any changes require resource replacement.",
@@ -205,6 +250,17 @@ func (r *flowInstanceResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	unlock, lerr := lockWorkspaceGroup(ctx, r.ClientWithResponsesInterface, state.WorkspaceID, fmt.Sprintf("Deleting the Flow instance %s", state.ID.ValueString()))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
+
 	flowDeleteResponse, err := r.DeleteV1FlowFlowIDWithResponse(ctx, uuid.MustParse(state.ID.ValueString()))
 	if serr := util.StatusOK(flowDeleteResponse, err, util.ReturnNilOnNotFound); serr != nil {
 		resp.Diagnostics.AddError(
@@ -225,19 +281,56 @@ func (r *flowInstanceResource) Configure(_ context.Context, req resource.Configu
 	r.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
 }
 
+// lockWorkspaceGroup serializes the operation with the other operations in the workspace group of the Flow instance workspace.
+// If the workspace no longer exists, there is nothing to serialize with and the returned unlock function does nothing.
+func lockWorkspaceGroup(ctx context.Context, c management.ClientWithResponsesInterface, workspaceID types.String, operation string) (func(), *util.SummaryWithDetailError) {
+	workspaceGroupID, serr := workspaceGroupOf(ctx, c, workspaceID)
+	if serr != nil {
+		return nil, serr
+	}
+
+	if workspaceGroupID.IsNull() {
+		return func() {}, nil
+	}
+
+	return util.LockWorkspaceGroup(ctx, workspaceGroupID.ValueString(), operation)
+}
+
+// workspaceGroupOf returns the workspace group of the workspace, or null if the workspace is not known or no longer exists.
+func workspaceGroupOf(ctx context.Context, c management.ClientWithResponsesInterface, workspaceID types.String) (types.String, *util.SummaryWithDetailError) {
+	if !util.IsConfiguredString(workspaceID) {
+		return types.StringNull(), nil
+	}
+
+	workspace, err := c.GetV1WorkspacesWorkspaceIDWithResponse(ctx, uuid.MustParse(workspaceID.ValueString()), &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err, util.ReturnNilOnNotFound); serr != nil {
+		return types.StringNull(), serr
+	}
+
+	if workspace.JSON200 == nil {
+		return types.StringNull(), nil
+	}
+
+	return types.StringValue(workspace.JSON200.WorkspaceGroupID.String()), nil
+}
+
 // ModifyPlan emits an error if a required yet immutable field changes or if incompatible state is set.
 func (r *flowInstanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var state *flowInstanceResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || state == nil {
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	var plan *flowInstanceResourceModel
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || plan == nil {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state == nil || plan == nil {
 		return
 	}
 
@@ -251,6 +344,7 @@ func (r *flowInstanceResource) ModifyPlan(ctx context.Context, req resource.Modi
 	}
 
 	adoptFlowCreateOnlyPlanFields(ctx, resp, plan, state)
+	util.PlanPendingCreate(ctx, req, resp, "created_at", "endpoint")
 }
 
 func appendFlowImmutableFieldPlanErrors(resp *resource.ModifyPlanResponse, plan, state *flowInstanceResourceModel) {
@@ -0,0 +1,399 @@
+package globalvariables
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "global_variable"
+
+	onDestroyPrior   = "PRIOR"
+	onDestroyDefault = "DEFAULT"
+	onDestroyKeep    = "KEEP"
+)
+
+var (
+	_ resource.Resource                = &globalVariableResource{}
+	_ resource.ResourceWithConfigure   = &globalVariableResource{}
+	_ resource.ResourceWithImportState = &globalVariableResource{}
+
+	variableNameRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+	numberRegexp       = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+	sizeRegexp         = regexp.MustCompile(`^([0-9]+)([KMG])$`)
+)
+
+type globalVariableResourceModel struct {
+	sql.ConnectionModel
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Value      types.String `tfsdk:"value"`
+	PriorValue types.String `tfsdk:"prior_value"`
+	OnDestroy  types.String `tfsdk:"on_destroy"`
+}
+
+type globalVariableResource struct {
+	sql.Connector
+}
+
+func NewResource() resource.Resource {
+	return &globalVariableResource{}
+}
+
+func (r *globalVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+func (r *globalVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage an engine variable of a SingleStore Helios workspace via the Data API. " +
+			"The 'apply' action runs `SET GLOBAL`, and the 'destroy' action restores the value from before the first apply. " +
+			"Changes made outside of Terraform are detected through `SHOW GLOBAL VARIABLES`; " +
+			"values are compared in a normalized form, so `ON`, `true`, and `1` or `1M` and `1048576` are equal.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the variable in the form `<endpoint>/<name>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the variable, e.g., `default_partitions_per_leaf`. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(variableNameRegexp, "must be a variable name in lower case"),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The value of the variable. Numbers, `ON`/`OFF`, `TRUE`/`FALSE`, and sizes with a `K`, `M`, or `G` suffix are set as is; " +
+					"other values are set as strings.",
+			},
+			"prior_value": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The value of the variable before the first apply, as reported by `SHOW GLOBAL VARIABLES`. Not set for imported variables.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(onDestroyPrior),
+				MarkdownDescription: fmt.Sprintf("What the 'destroy' action sets the variable to: `%s` restores `prior_value`, `%s` restores the engine default, and `%s` leaves the variable as is. "+
+					"Defaults to `%s`. Variables without a `prior_value` are restored to the engine default.",
+					onDestroyPrior, onDestroyDefault, onDestroyKeep, onDestroyPrior,
+				),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onDestroyPrior, onDestroyDefault, onDestroyKeep),
+				},
+			},
+		}),
+	}
+}
+
+func (r *globalVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan globalVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	prior, serr := readVariable(ctx, client, name)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if serr := setVariable(ctx, client, name, formatValue(plan.Value.ValueString())); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), name))
+	plan.PriorValue = types.StringValue(prior)
+
+	r.warnIfNotApplied(ctx, client, plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *globalVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state globalVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	current, err := queryVariable(ctx, client, state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if current == nil {
+		serr := unknownVariableError(state.Name.ValueString())
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	// The configured form is kept while it is equivalent, e.g., 1M for 1048576.
+	if state.Value.IsNull() || !equivalentValues(state.Value.ValueString(), *current) {
+		state.Value = types.StringValue(*current)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *globalVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state globalVariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if !plan.Value.Equal(state.Value) {
+		if serr := setVariable(ctx, client, plan.Name.ValueString(), formatValue(plan.Value.ValueString())); serr != nil {
+			resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+			return
+		}
+
+		r.warnIfNotApplied(ctx, client, plan, &resp.Diagnostics)
+	}
+
+	plan.ConnectionModel = plan.ForState()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *globalVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state globalVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value := restoreValue(state)
+	if value == "" {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: setStatement(state.Name.ValueString(), value)}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *globalVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *globalVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := sql.ImportConnection(ctx, req, resp, "`<endpoint>/<name>`", 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), names[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), onDestroyPrior)...)
+}
+
+// warnIfNotApplied warns if the engine reports a value other than the configured one, e.g., a clamped value.
+// The next plan shows the difference.
+func (r *globalVariableResource) warnIfNotApplied(ctx context.Context, client *sql.Client, plan globalVariableResourceModel, diags *diag.Diagnostics) {
+	current, err := queryVariable(ctx, client, plan.Name.ValueString())
+	if err != nil || current == nil || equivalentValues(plan.Value.ValueString(), *current) {
+		return
+	}
+
+	diags.AddWarning(
+		fmt.Sprintf("Variable %s has a different value", plan.Name.ValueString()),
+		fmt.Sprintf("The workspace reports %q after setting %q. The engine may round or limit the value.", *current, plan.Value.ValueString()),
+	)
+}
+
+// restoreValue returns the value that the 'destroy' action sets, or an empty string to keep the variable as is.
+func restoreValue(state globalVariableResourceModel) string {
+	switch {
+	case state.OnDestroy.ValueString() == onDestroyKeep:
+		return ""
+	case state.OnDestroy.ValueString() == onDestroyPrior && !state.PriorValue.IsNull() && !state.PriorValue.IsUnknown():
+		return formatValue(state.PriorValue.ValueString())
+	default:
+		return onDestroyDefault
+	}
+}
+
+func setStatement(name, formattedValue string) string {
+	return fmt.Sprintf("SET GLOBAL %s = %s", name, formattedValue)
+}
+
+func setVariable(ctx context.Context, client *sql.Client, name, formattedValue string) *util.SummaryWithDetailError {
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: setStatement(name, formattedValue)}); err != nil {
+		return sql.DiagnosticFromError(err)
+	}
+
+	return nil
+}
+
+func readVariable(ctx context.Context, client *sql.Client, name string) (string, *util.SummaryWithDetailError) {
+	current, err := queryVariable(ctx, client, name)
+	if err != nil {
+		return "", sql.DiagnosticFromError(err)
+	}
+
+	if current == nil {
+		return "", unknownVariableError(name)
+	}
+
+	return *current, nil
+}
+
+// queryVariable returns nil if the workspace has no such variable.
+func queryVariable(ctx context.Context, client *sql.Client, name string) (*string, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  "SHOW GLOBAL VARIABLES LIKE ?",
+		Args: []any{name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// LIKE treats '_' as a wildcard, so the names are compared exactly.
+	for _, row := range rows {
+		if row["Variable_name"] == name {
+			value := row["Value"]
+
+			return &value, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func unknownVariableError(name string) *util.SummaryWithDetailError {
+	return &util.SummaryWithDetailError{
+		Summary: "Unknown variable",
+		Detail:  fmt.Sprintf("The workspace has no global variable %s. Check the name with SHOW GLOBAL VARIABLES.", name),
+	}
+}
+
+// formatValue returns the value as written in SET GLOBAL.
+func formatValue(value string) string {
+	upper := strings.ToUpper(value)
+	switch {
+	case numberRegexp.MatchString(value), sizeRegexp.MatchString(upper):
+		return upper
+	case upper == "ON" || upper == "OFF" || upper == "TRUE" || upper == "FALSE" || upper == onDestroyDefault:
+		return upper
+	default:
+		return sql.QuoteString(value)
+	}
+}
+
+// normalizeValue returns the canonical form of a value for comparison: booleans become ON or OFF,
+// sizes become bytes, numbers lose insignificant zeros, and other values are compared case-insensitively.
+func normalizeValue(value string) string {
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	switch value {
+	case "ON", "TRUE", "YES":
+		return "ON"
+	case "OFF", "FALSE", "NO":
+		return "OFF"
+	}
+
+	if m := sizeRegexp.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.ParseInt(m[1], 10, 64)
+
+		return strconv.FormatInt(n<<(10*(strings.Index("KMG", m[2])+1)), 10)
+	}
+
+	if numberRegexp.MatchString(value) {
+		if r, ok := new(big.Rat).SetString(value); ok {
+			return r.RatString()
+		}
+	}
+
+	return value
+}
+
+// equivalentValues reports whether two values of a variable are the same in the normalized form.
+// 1 and 0 are equivalent to ON and OFF, since the engine reports booleans either way.
+func equivalentValues(a, b string) bool {
+	na, nb := normalizeValue(a), normalizeValue(b)
+	if na == nb {
+		return true
+	}
+
+	booleans := map[string]string{"1": "ON", "0": "OFF"}
+	if v, ok := booleans[na]; ok {
+		na = v
+	}
+
+	if v, ok := booleans[nb]; ok {
+		nb = v
+	}
+
+	return na == nb
+}
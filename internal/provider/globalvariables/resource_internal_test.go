@@ -0,0 +1,62 @@
+package globalvariables
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEquivalentValues(t *testing.T) {
+	for _, pair := range [][2]string{
+		{"ON", "on"},
+		{"true", "ON"},
+		{"1", "ON"},
+		{"off", "0"},
+		{"1M", "1048576"},
+		{"2G", "2147483648"},
+		{"1000", "1000.000"},
+		{"0.50", "0.5"},
+		{"Read-Committed", "READ-COMMITTED"},
+	} {
+		require.True(t, equivalentValues(pair[0], pair[1]), "%s and %s", pair[0], pair[1])
+	}
+
+	for _, pair := range [][2]string{
+		{"ON", "OFF"},
+		{"2", "ON"},
+		{"1K", "1000"},
+		{"abc", "abd"},
+	} {
+		require.False(t, equivalentValues(pair[0], pair[1]), "%s and %s", pair[0], pair[1])
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	require.Equal(t, "1024000", formatValue("1024000"))
+	require.Equal(t, "-1.5", formatValue("-1.5"))
+	require.Equal(t, "64M", formatValue("64m"))
+	require.Equal(t, "ON", formatValue("on"))
+	require.Equal(t, "DEFAULT", formatValue("default"))
+	require.Equal(t, `'it\'s'`, formatValue("it's"))
+	require.Equal(t, "TRUE", formatValue("true"))
+}
+
+func TestRestoreValue(t *testing.T) {
+	state := globalVariableResourceModel{
+		PriorValue: types.StringValue("8"),
+		OnDestroy:  types.StringValue(onDestroyPrior),
+	}
+	require.Equal(t, "8", restoreValue(state))
+
+	state.OnDestroy = types.StringValue(onDestroyDefault)
+	require.Equal(t, "DEFAULT", restoreValue(state))
+
+	state.OnDestroy = types.StringValue(onDestroyKeep)
+	require.Empty(t, restoreValue(state))
+
+	// Imported variables have no prior value.
+	state.OnDestroy = types.StringValue(onDestroyPrior)
+	state.PriorValue = types.StringNull()
+	require.Equal(t, "DEFAULT", restoreValue(state))
+}
@@ -0,0 +1,181 @@
+package globalvariables_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var setGlobalRegexp = regexp.MustCompile(`^SET GLOBAL (\w+) = (.+)$`)
+
+// fakeVariables keeps the global variables of a mock workspace.
+type fakeVariables struct {
+	*testutil.FakeWorkspace
+	values map[string]string
+}
+
+func newFakeVariables(t *testing.T) *fakeVariables {
+	t.Helper()
+
+	w := &fakeVariables{values: map[string]string{
+		"columnstore_segment_rows":    "1024000",
+		"columnstore_segment_rows_x":  "1",
+		"default_partitions_per_leaf": "8",
+	}}
+	defaults := map[string]string{"columnstore_segment_rows": "1024000"}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: setGlobalRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				w.Record(req.SQL)
+
+				if m[2] == "DEFAULT" {
+					m[2] = defaults[m[1]]
+				}
+
+				w.values[m[1]] = m[2]
+
+				return nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("SHOW GLOBAL VARIABLES LIKE ?"),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				// LIKE matches columnstore_segment_rows to columnstore_segment_rows_x as well.
+				var rows []map[string]any
+				for name, value := range w.values {
+					if name == req.Args[0] || name == req.Args[0].(string)+"_x" {
+						rows = append(rows, map[string]any{"Variable_name": name, "Value": value})
+					}
+				}
+
+				return rows, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeVariables) setOutsideTerraform(name, value string) {
+	w.Do(func() {
+		w.values[name] = value
+	})
+}
+
+func (w *fakeVariables) value(name string) string {
+	var value string
+	w.Do(func() {
+		value = w.values[name]
+	})
+
+	return value
+}
+
+func variableConfig(name, value, onDestroy string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_global_variable" "this" {
+  endpoint   = %q
+  password   = "secret"
+  name       = %q
+  value      = %q
+  on_destroy = %q
+}
+`, testutil.TestWorkspaceEndpoint, name, value, onDestroy)
+}
+
+func TestCRUDGlobalVariable(t *testing.T) {
+	w := newFakeVariables(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: variableConfig("default_partitions_per_leaf", "16", "PRIOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_global_variable.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/default_partitions_per_leaf"),
+					resource.TestCheckResourceAttr("singlestoredb_global_variable.this", "value", "16"),
+					resource.TestCheckResourceAttr("singlestoredb_global_variable.this", "prior_value", "8"),
+				),
+			},
+			{
+				// An equivalent value reported in another form is not a change.
+				PreConfig: func() {
+					w.setOutsideTerraform("default_partitions_per_leaf", "16.0")
+				},
+				Config:   variableConfig("default_partitions_per_leaf", "16", "PRIOR"),
+				PlanOnly: true,
+			},
+			{
+				// The value changed outside of Terraform is set again.
+				PreConfig: func() {
+					w.setOutsideTerraform("default_partitions_per_leaf", "4")
+				},
+				Config: variableConfig("default_partitions_per_leaf", "16", "PRIOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_global_variable.this", "value", "16"),
+					resource.TestCheckResourceAttr("singlestoredb_global_variable.this", "prior_value", "8"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, "8", w.value("default_partitions_per_leaf"), "destroy should restore the prior value")
+	require.Equal(t, []string{
+		"SET GLOBAL default_partitions_per_leaf = 16",
+		"SET GLOBAL default_partitions_per_leaf = 16",
+		"SET GLOBAL default_partitions_per_leaf = 8",
+	}, w.Executed())
+}
+
+func TestGlobalVariableRestoresDefault(t *testing.T) {
+	w := newFakeVariables(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: variableConfig("columnstore_segment_rows", "2048000", "DEFAULT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_global_variable.this", "prior_value", "1024000"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, "1024000", w.value("columnstore_segment_rows"))
+	require.Equal(t, "1", w.value("columnstore_segment_rows_x"), "a variable matching the LIKE pattern should not change")
+	require.Equal(t, []string{
+		"SET GLOBAL columnstore_segment_rows = 2048000",
+		"SET GLOBAL columnstore_segment_rows = DEFAULT",
+	}, w.Executed())
+}
+
+func TestGlobalVariableIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.GlobalVariableResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_global_variable.segment_rows", "value", "2048000"),
+					resource.TestCheckResourceAttrSet("singlestoredb_global_variable.segment_rows", "prior_value"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,340 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "link"
+)
+
+var (
+	_ resource.Resource              = &linkResource{}
+	_ resource.ResourceWithConfigure = &linkResource{}
+
+	connectionTypes = []string{"S3", "KAFKA", "HDFS", "GCS", "AZURE"}
+)
+
+type linkResourceModel struct {
+	sql.ConnectionModel
+	ID                 types.String `tfsdk:"id"`
+	Database           types.String `tfsdk:"database"`
+	Name               types.String `tfsdk:"name"`
+	ConnectionType     types.String `tfsdk:"connection_type"`
+	Credentials        types.String `tfsdk:"credentials"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+	Config             types.String `tfsdk:"config"`
+	Description        types.String `tfsdk:"description"`
+}
+
+// linkInfo is a row of information_schema.LINKS.
+type linkInfo struct {
+	ConnectionType string
+	// Description and Config are nil if the workspace does not report them.
+	Description *string
+	Config      *string
+}
+
+type linkResource struct {
+	sql.Connector
+}
+
+func NewResource() resource.Resource {
+	return &linkResource{}
+}
+
+func (r *linkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+func (r *linkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a link of a SingleStore Helios workspace via the Data API. " +
+			"A link (`CREATE LINK`) stores the credentials and the configuration of an external S3, Kafka, HDFS, GCS, or Azure connection in a database, " +
+			"so that pipelines and `SELECT ... INTO` refer to the link by name instead of repeating the credentials inline. " +
+			"Links cannot be altered, so changing any of the link attributes replaces the link. " +
+			"Changes made outside of Terraform are detected through `information_schema.LINKS`.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the link in the form `<endpoint>/<database>/<name>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The database of the link. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the link. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"connection_type": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: fmt.Sprintf("The type of the external connection. Valid values are %s. Changing this value forces replacement.",
+					util.Join(connectionTypes, ", "),
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectionTypes...),
+				},
+			},
+			"credentials": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "The JSON credentials of the connection, e.g., `{\"aws_access_key_id\": \"...\", \"aws_secret_access_key\": \"...\"}`. " +
+					"The credentials are write-only: they are not stored in the plan or the state, which requires Terraform 1.11 or later. " +
+					"A new value is only applied when `credentials_version` changes, and changes made outside of Terraform are not detected.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(2),
+				},
+			},
+			"credentials_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of `credentials`. Changing this value replaces the link with the current `credentials`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The JSON configuration of the connection, e.g., `{\"region\": \"us-east-1\"}`. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(2),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The description of the link. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		}),
+	}
+}
+
+func (r *linkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan linkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// The credentials are write-only, so they are only available in the configuration.
+	// The framework removes them from the state.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credentials"), &plan.Credentials)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: createLinkStatement(plan)}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Database.ValueString(), plan.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *linkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state linkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	info, err := readLink(ctx, client, state.Database.ValueString(), state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if info == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, toLinkResourceModel(state, *info))...)
+}
+
+func (r *linkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan linkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the credentials of the connection to the workspace change in place.
+	plan.ConnectionModel = plan.ForState()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *linkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state linkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	info, err := readLink(ctx, client, state.Database.ValueString(), state.Name.ValueString())
+	if err == nil && info != nil {
+		_, err = client.Exec(ctx, sql.ExecRequest{SQL: "DROP LINK " + linkName(state)})
+	}
+
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *linkResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func linkName(model linkResourceModel) string {
+	return sql.QuoteIdentifier(model.Database.ValueString()) + "." + sql.QuoteIdentifier(model.Name.ValueString())
+}
+
+func createLinkStatement(model linkResourceModel) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "CREATE LINK %s AS %s CREDENTIALS %s",
+		linkName(model), model.ConnectionType.ValueString(), sql.QuoteString(model.Credentials.ValueString()),
+	)
+
+	if util.IsConfiguredString(model.Config) {
+		fmt.Fprintf(&b, " CONFIG %s", sql.QuoteString(model.Config.ValueString()))
+	}
+
+	if util.IsConfiguredString(model.Description) {
+		fmt.Fprintf(&b, " DESCRIPTION %s", sql.QuoteString(model.Description.ValueString()))
+	}
+
+	return b.String()
+}
+
+// readLink returns nil if the link does not exist.
+func readLink(ctx context.Context, client *sql.Client, database, name string) (*linkInfo, error) {
+	// The columns of information_schema.LINKS differ between engine versions,
+	// so DESCRIPTION and CONFIG are only compared when they are present.
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  "SELECT * FROM information_schema.LINKS WHERE DATABASE_NAME = ? AND LINK = ?",
+		Args: []any{database, name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	info := linkInfo{ConnectionType: rows[0]["CONNECTION_TYPE"]}
+	if value, ok := rows[0]["DESCRIPTION"]; ok {
+		info.Description = &value
+	}
+
+	if value, ok := rows[0]["CONFIG"]; ok && value != "" {
+		info.Config = &value
+	}
+
+	return &info, nil
+}
+
+// toLinkResourceModel replaces the attributes that differ from the link in the workspace,
+// which plans the replacement of the link.
+func toLinkResourceModel(model linkResourceModel, info linkInfo) linkResourceModel {
+	model.ConnectionModel = model.ForState()
+
+	if !strings.EqualFold(model.ConnectionType.ValueString(), info.ConnectionType) {
+		model.ConnectionType = types.StringValue(strings.ToUpper(info.ConnectionType))
+	}
+
+	if info.Description != nil && model.Description.ValueString() != *info.Description {
+		model.Description = types.StringValue(*info.Description)
+	}
+
+	if info.Config != nil && !equivalentJSON(model.Config.ValueString(), *info.Config) {
+		model.Config = types.StringValue(*info.Config)
+	}
+
+	return model
+}
+
+// equivalentJSON reports whether both values are the same JSON document, ignoring formatting and key order.
+// An empty value stands for an empty object.
+func equivalentJSON(a, b string) bool {
+	var x, y any
+	if err := json.Unmarshal([]byte(util.FirstNotEmpty(a, "{}")), &x); err != nil {
+		return a == b
+	}
+
+	if err := json.Unmarshal([]byte(util.FirstNotEmpty(b, "{}")), &y); err != nil {
+		return a == b
+	}
+
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,64 @@
+package links
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLinkStatement(t *testing.T) {
+	model := linkResourceModel{
+		Database:       types.StringValue("app"),
+		Name:           types.StringValue("s3"),
+		ConnectionType: types.StringValue("S3"),
+		Credentials:    types.StringValue(`{"aws_secret_access_key": "it's"}`),
+		Config:         types.StringNull(),
+		Description:    types.StringNull(),
+	}
+	require.Equal(t,
+		"CREATE LINK `app`.`s3` AS S3 CREDENTIALS '{\"aws_secret_access_key\": \"it\\'s\"}'",
+		createLinkStatement(model),
+	)
+
+	model.Config = types.StringValue(`{"region": "us-east-1"}`)
+	model.Description = types.StringValue("Orders bucket")
+	require.Equal(t,
+		"CREATE LINK `app`.`s3` AS S3 CREDENTIALS '{\"aws_secret_access_key\": \"it\\'s\"}' CONFIG '{\"region\": \"us-east-1\"}' DESCRIPTION 'Orders bucket'",
+		createLinkStatement(model),
+	)
+}
+
+func TestEquivalentJSON(t *testing.T) {
+	require.True(t, equivalentJSON(`{"region": "us-east-1", "endpoint_url": "x"}`, `{"endpoint_url":"x","region":"us-east-1"}`))
+	require.True(t, equivalentJSON("", "{}"))
+	require.False(t, equivalentJSON(`{"region": "us-east-1"}`, `{"region": "us-west-2"}`))
+	require.False(t, equivalentJSON("", `{"region": "us-east-1"}`))
+	require.True(t, equivalentJSON("not json", "not json"))
+	require.False(t, equivalentJSON("not json", "{}"))
+}
+
+func TestToLinkResourceModel(t *testing.T) {
+	model := linkResourceModel{
+		ConnectionType: types.StringValue("S3"),
+		Config:         types.StringValue(`{"region": "us-east-1"}`),
+		Description:    types.StringNull(),
+	}
+
+	require.Equal(t, model, toLinkResourceModel(model, linkInfo{ConnectionType: "s3"}), "unreported columns are not compared")
+	require.Equal(t, model, toLinkResourceModel(model, linkInfo{
+		ConnectionType: "S3",
+		Description:    util.Ptr(""),
+		Config:         util.Ptr(`{"region":"us-east-1"}`),
+	}))
+
+	drifted := toLinkResourceModel(model, linkInfo{
+		ConnectionType: "GCS",
+		Description:    util.Ptr("changed"),
+		Config:         util.Ptr(`{"region":"us-west-2"}`),
+	})
+	require.Equal(t, "GCS", drifted.ConnectionType.ValueString())
+	require.Equal(t, "changed", drifted.Description.ValueString())
+	require.JSONEq(t, `{"region":"us-west-2"}`, drifted.Config.ValueString())
+}
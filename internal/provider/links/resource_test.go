@@ -0,0 +1,207 @@
+package links_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var createLinkRegexp = regexp.MustCompile("^CREATE LINK `app`\\.`orders` AS (\\w+) CREDENTIALS '[^']*'(?: CONFIG '([^']*)')?(?: DESCRIPTION '([^']*)')?$")
+
+// fakeLinks keeps the link `app`.`orders` of a mock workspace.
+type fakeLinks struct {
+	*testutil.FakeWorkspace
+	link map[string]any
+}
+
+func newFakeLinks(t *testing.T) *fakeLinks {
+	t.Helper()
+
+	w := &fakeLinks{}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: createLinkRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				w.Record(req.SQL)
+
+				w.link = map[string]any{
+					"DATABASE_NAME":   "app",
+					"LINK":            "orders",
+					"CONNECTION_TYPE": m[1],
+					"DESCRIPTION":     m[3],
+					"CONFIG":          m[2],
+				}
+
+				return nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("DROP LINK `app`.`orders`"),
+			Exec: func(req testutil.DataAPIRequest, _ []string) error {
+				w.Record(req.SQL)
+
+				w.link = nil
+
+				return nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("SELECT * FROM information_schema.LINKS WHERE DATABASE_NAME = ? AND LINK = ?"),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				require.Equal(t, []any{"app", "orders"}, req.Args)
+
+				if w.link == nil {
+					return nil, nil
+				}
+
+				return []map[string]any{w.link}, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeLinks) setOutsideTerraform(column string, value any) {
+	w.Do(func() {
+		w.link[column] = value
+	})
+}
+
+func linkConfig(region, description string) string {
+	return linkConfigWithCredentials(region, description, "secret", 1)
+}
+
+func linkConfigWithCredentials(region, description, secretAccessKey string, credentialsVersion int) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_link" "this" {
+  endpoint        = %q
+  password        = "secret"
+  database        = "app"
+  name            = "orders"
+  connection_type = "S3"
+  credentials     = jsonencode({ aws_access_key_id = "key", aws_secret_access_key = %q })
+  config          = jsonencode({ region = %q })
+  description     = %q
+
+  credentials_version = %d
+}
+`, testutil.TestWorkspaceEndpoint, secretAccessKey, region, description, credentialsVersion)
+}
+
+func TestCRUDLink(t *testing.T) {
+	w := newFakeLinks(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: linkConfig("us-east-1", "Orders bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_link.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/app/orders"),
+					resource.TestCheckResourceAttr("singlestoredb_link.this", "connection_type", "S3"),
+					resource.TestCheckResourceAttr("singlestoredb_link.this", "config", `{"region":"us-east-1"}`),
+					resource.TestCheckNoResourceAttr("singlestoredb_link.this", "credentials"),
+				),
+			},
+			{
+				// An equivalent configuration reported in another form is not a change.
+				PreConfig: func() {
+					w.setOutsideTerraform("CONFIG", `{ "region": "us-east-1" }`)
+				},
+				Config:   linkConfig("us-east-1", "Orders bucket"),
+				PlanOnly: true,
+			},
+			{
+				// A link recreated outside of Terraform with another configuration is replaced.
+				PreConfig: func() {
+					w.setOutsideTerraform("CONFIG", `{"region":"us-west-2"}`)
+				},
+				Config: linkConfig("us-east-1", "Orders bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_link.this", "config", `{"region":"us-east-1"}`),
+				),
+			},
+			{
+				Config: linkConfig("us-west-2", "Orders bucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_link.this", "config", `{"region":"us-west-2"}`),
+				),
+			},
+			{
+				// The write-only credentials are not compared, so they are only applied when their version changes.
+				Config:   linkConfigWithCredentials("us-west-2", "Orders bucket", "rotated", 1),
+				PlanOnly: true,
+			},
+			{
+				Config: linkConfigWithCredentials("us-west-2", "Orders bucket", "rotated", 2),
+			},
+		},
+	})
+
+	create := func(region, secretAccessKey string) string {
+		return "CREATE LINK `app`.`orders` AS S3 CREDENTIALS " +
+			`'{"aws_access_key_id":"key","aws_secret_access_key":"` + secretAccessKey + `"}' ` +
+			`CONFIG '{"region":"` + region + `"}' DESCRIPTION 'Orders bucket'`
+	}
+	drop := "DROP LINK `app`.`orders`"
+	require.Equal(t, []string{
+		create("us-east-1", "secret"),
+		drop, create("us-east-1", "secret"),
+		drop, create("us-west-2", "secret"),
+		drop, create("us-west-2", "rotated"),
+		drop,
+	}, w.Executed())
+}
+
+func TestLinkDroppedOutsideOfTerraform(t *testing.T) {
+	w := newFakeLinks(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: linkConfig("us-east-1", ""),
+			},
+			{
+				PreConfig: func() {
+					w.Do(func() {
+						w.link = nil
+					})
+				},
+				Config:             linkConfig("us-east-1", ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestLinkResourceIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.LinkResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_link.orders", "connection_type", "S3"),
+					resource.TestCheckResourceAttrSet("singlestoredb_link.orders", config.IDAttribute),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,633 @@
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "sql_migrations"
+
+	defaultTrackingTable = "schema_migrations"
+)
+
+var (
+	_ resource.Resource                   = &migrationsResource{}
+	_ resource.ResourceWithConfigure      = &migrationsResource{}
+	_ resource.ResourceWithValidateConfig = &migrationsResource{}
+	_ resource.ResourceWithModifyPlan     = &migrationsResource{}
+
+	migrationAttrTypes = map[string]attr.Type{
+		"version": types.StringType,
+		"up":      types.StringType,
+		"down":    types.StringType,
+	}
+
+	identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+type migrationsResourceModel struct {
+	sql.ConnectionModel
+	ID              types.String `tfsdk:"id"`
+	Database        types.String `tfsdk:"database"`
+	TrackingTable   types.String `tfsdk:"tracking_table"`
+	Migrations      types.List   `tfsdk:"migrations"`
+	RevertOnDestroy types.Bool   `tfsdk:"revert_on_destroy"`
+	AppliedVersions types.List   `tfsdk:"applied_versions"`
+	PendingVersions types.List   `tfsdk:"pending_versions"`
+}
+
+type migrationModel struct {
+	Version types.String `tfsdk:"version"`
+	Up      types.String `tfsdk:"up"`
+	Down    types.String `tfsdk:"down"`
+}
+
+// appliedMigration is a row of the tracking table.
+type appliedMigration struct {
+	Version  string
+	Checksum string
+}
+
+type migrationsResource struct {
+	sql.Connector
+}
+
+func NewResource() resource.Resource {
+	return &migrationsResource{}
+}
+
+func (r *migrationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+func (r *migrationsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apply versioned schema migrations to a database of a SingleStore Helios workspace via the Data API. " +
+			"The applied versions and the SHA-256 checksums of their `up` scripts are recorded in a tracking table, " +
+			"so each apply runs only the pending migrations, in the listed order. " +
+			"A migration whose `up` script changed after it was applied fails the apply instead of running again. " +
+			"Migrations removed from the list are rolled back with their `down` scripts, newest first. " +
+			"Each script may contain several statements separated by semicolons; the statements run one by one, " +
+			"so a failed migration can leave the statements before the failure applied, and the migration is not recorded.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the migrations in the form `<endpoint>/<database>/<tracking_table>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The database that the migrations run in and that holds the tracking table. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"tracking_table": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultTrackingTable),
+				MarkdownDescription: fmt.Sprintf("The table that records the applied migrations. Defaults to `%s`. Changing this value forces replacement.", defaultTrackingTable),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(identifierRegexp, "must be an unquoted identifier"),
+					stringvalidator.LengthAtMost(64),
+				},
+			},
+			"migrations": schema.ListNestedAttribute{
+				Required: true,
+				MarkdownDescription: "The migrations in the order to apply them. " +
+					"Typically built from a directory with `fileset`, e.g., one `<version>.up.sql` and an optional `<version>.down.sql` file per version.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The unique version of the migration, e.g., `0001` or `20260101120000`.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"up": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The script that applies the migration. Must not change after the migration is applied.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"down": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The script that rolls the migration back when it is removed from the list or, with `revert_on_destroy`, when the resource is destroyed.",
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"revert_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether destroying the resource rolls back all the applied migrations with their `down` scripts and drops the tracking table. Defaults to `false`, which keeps the schema.",
+			},
+			"applied_versions": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The versions of the listed migrations that are recorded in the tracking table, in the listed order.",
+			},
+			"pending_versions": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The versions of the listed migrations that are not applied yet, in the listed order. Empty after a successful apply.",
+			},
+		}),
+	}
+}
+
+func (r *migrationsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var conf migrationsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if resp.Diagnostics.HasError() || conf.Migrations.IsUnknown() {
+		return
+	}
+
+	migrations, diags := listMigrations(ctx, conf.Migrations)
+	resp.Diagnostics.Append(diags...)
+
+	seen := make(map[string]bool, len(migrations))
+	for i, m := range migrations {
+		if m.Version.IsUnknown() {
+			continue
+		}
+
+		version := m.Version.ValueString()
+		if seen[version] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("migrations").AtListIndex(i).AtName("version"),
+				"Invalid configuration",
+				fmt.Sprintf("The version %q is listed more than once.", version),
+			)
+		}
+
+		seen[version] = true
+	}
+}
+
+func (r *migrationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan migrationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A successful apply applies all the listed migrations.
+	applied, pending := types.ListUnknown(types.StringType), types.ListUnknown(types.StringType)
+
+	if migrations, diags := listMigrations(ctx, plan.Migrations); !plan.Migrations.IsUnknown() && !diags.HasError() &&
+		!slices.ContainsFunc(migrations, func(m migrationModel) bool { return m.Version.IsUnknown() }) {
+		applied = versionList(migrations)
+		pending = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("applied_versions"), applied)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_versions"), pending)...)
+}
+
+func (r *migrationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan migrationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Migrations applied by an interrupted apply are recorded in the tracking table, so they are skipped.
+	resp.Diagnostics.Append(r.migrate(ctx, plan, nil, &resp.State)...)
+}
+
+func (r *migrationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state migrationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	appliedMigrations, err := readApplied(ctx, client, state.Database.ValueString(), state.TrackingTable.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	migrations, diags := listMigrations(ctx, state.Migrations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Migrations that are not recorded, or that are recorded with another checksum, are removed from the state,
+	// so the next plan shows them as changes and the next apply either runs them or reports the mismatch.
+	checksums := checksumsByVersion(appliedMigrations)
+
+	var kept, pending []migrationModel
+	for _, m := range migrations {
+		checksum, ok := checksums[m.Version.ValueString()]
+		switch {
+		case !ok:
+			pending = append(pending, m)
+		case checksum != migrationChecksum(m):
+			pending = append(pending, m)
+			resp.Diagnostics.AddWarning(
+				"Migration checksum mismatch",
+				fmt.Sprintf("The migration %s is recorded in %s with another checksum than its up script in the state. "+
+					"The next apply fails until the script or the checksum in the tracking table is corrected.",
+					m.Version.ValueString(), state.TrackingTable.ValueString(),
+				),
+			)
+		default:
+			kept = append(kept, m)
+		}
+	}
+
+	state.ConnectionModel = state.ForState()
+	state.Migrations, diags = migrationList(ctx, kept)
+	resp.Diagnostics.Append(diags...)
+	state.AppliedVersions = versionList(kept)
+	state.PendingVersions = versionList(pending)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *migrationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state migrationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The down scripts of the migrations removed from the configuration are only in the state.
+	prior, diags := listMigrations(ctx, state.Migrations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.migrate(ctx, plan, prior, &resp.State)...)
+}
+
+func (r *migrationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state migrationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !state.RevertOnDestroy.ValueBool() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	prior, diags := listMigrations(ctx, state.Migrations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	database, table := state.Database.ValueString(), state.TrackingTable.ValueString()
+
+	err := func() error {
+		appliedMigrations, err := readApplied(ctx, client, database, table)
+		if err != nil {
+			return err
+		}
+
+		if err := rollBack(ctx, client, database, table, appliedMigrations, nil, prior); err != nil {
+			return err
+		}
+
+		_, err = client.Exec(ctx, sql.ExecRequest{SQL: "DROP TABLE IF EXISTS " + sql.QuoteIdentifier(table), Database: database})
+
+		return err
+	}()
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *migrationsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+// migrate rolls back the applied migrations that are not planned and applies the pending planned migrations.
+// prior holds the migrations of the state, which provide the down scripts.
+func (r *migrationsResource) migrate(ctx context.Context, plan migrationsResourceModel, prior []migrationModel, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		diags.AddError(serr.Summary, serr.Detail)
+
+		return diags
+	}
+
+	migrations, d := listMigrations(ctx, plan.Migrations)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	database, table := plan.Database.ValueString(), plan.TrackingTable.ValueString()
+
+	err := func() error {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: createTrackingTableStatement(table), Database: database}); err != nil {
+			return err
+		}
+
+		appliedMigrations, err := readApplied(ctx, client, database, table)
+		if err != nil {
+			return err
+		}
+
+		// All the checks run before the first statement.
+		if err := checkChecksums(migrations, appliedMigrations, table); err != nil {
+			return err
+		}
+
+		if err := rollBack(ctx, client, database, table, appliedMigrations, migrations, prior); err != nil {
+			return err
+		}
+
+		checksums := checksumsByVersion(appliedMigrations)
+		for _, m := range migrations {
+			if _, ok := checksums[m.Version.ValueString()]; ok {
+				continue
+			}
+
+			if err := runScript(ctx, client, database, m.Version.ValueString(), "up", m.Up.ValueString()); err != nil {
+				return err
+			}
+
+			if _, err := client.Exec(ctx, sql.ExecRequest{
+				SQL:      fmt.Sprintf("INSERT INTO %s (version, checksum, applied_at) VALUES (?, ?, NOW(6))", sql.QuoteIdentifier(table)),
+				Args:     []any{m.Version.ValueString(), migrationChecksum(m)},
+				Database: database,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}()
+	if err != nil {
+		serr := diagnosticFromError(err)
+		diags.AddError(serr.Summary, serr.Detail)
+
+		return diags
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), database, table))
+	plan.AppliedVersions = versionList(migrations)
+	plan.PendingVersions = types.ListValueMust(types.StringType, []attr.Value{})
+
+	diags.Append(state.Set(ctx, &plan)...)
+
+	return diags
+}
+
+// checkChecksums fails if a listed migration is recorded with another checksum than its up script.
+func checkChecksums(migrations []migrationModel, appliedMigrations []appliedMigration, table string) error {
+	checksums := checksumsByVersion(appliedMigrations)
+
+	for _, m := range migrations {
+		if checksum, ok := checksums[m.Version.ValueString()]; ok && checksum != migrationChecksum(m) {
+			return &util.SummaryWithDetailError{
+				Summary: "Migration checksum mismatch",
+				Detail: fmt.Sprintf("The up script of the migration %s changed after it was applied: the checksum recorded in %s is %s, the checksum of the script is %s. "+
+					"Applied migrations must not change; add a new migration instead, or correct the checksum in the tracking table if the change is intended.",
+					m.Version.ValueString(), table, checksum, migrationChecksum(m),
+				),
+			}
+		}
+	}
+
+	return nil
+}
+
+// rollBack runs the down scripts of the applied migrations that are not listed in migrations, newest first.
+// The down scripts are looked up in prior. No statement runs if any of the migrations cannot be rolled back.
+func rollBack(ctx context.Context, client *sql.Client, database, table string, appliedMigrations []appliedMigration, migrations, prior []migrationModel) error {
+	listed := make(map[string]bool, len(migrations))
+	for _, m := range migrations {
+		listed[m.Version.ValueString()] = true
+	}
+
+	downs := make(map[string]string, len(prior))
+	for _, m := range prior {
+		if util.IsConfiguredString(m.Down) {
+			downs[m.Version.ValueString()] = m.Down.ValueString()
+		}
+	}
+
+	var versions []string
+	for _, m := range slices.Backward(appliedMigrations) {
+		if listed[m.Version] {
+			continue
+		}
+
+		if _, ok := downs[m.Version]; !ok {
+			return &util.SummaryWithDetailError{
+				Summary: "Cannot roll back migration",
+				Detail: fmt.Sprintf("The migration %s is recorded in %s but is not listed, and there is no down script in the state to roll it back. "+
+					"List the migration again, or delete its row from the tracking table after reverting it manually.",
+					m.Version, table,
+				),
+			}
+		}
+
+		versions = append(versions, m.Version)
+	}
+
+	for _, version := range versions {
+		if err := runScript(ctx, client, database, version, "down", downs[version]); err != nil {
+			return err
+		}
+
+		if _, err := client.Exec(ctx, sql.ExecRequest{
+			SQL:      fmt.Sprintf("DELETE FROM %s WHERE version = ?", sql.QuoteIdentifier(table)),
+			Args:     []any{version},
+			Database: database,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scriptError is the failure of a statement of an up or down script.
+type scriptError struct {
+	Version   string
+	Direction string
+	Statement int
+	Err       error
+}
+
+func (e *scriptError) Error() string {
+	return fmt.Sprintf("statement %d of the %s script of the migration %s failed: %v", e.Statement, e.Direction, e.Version, e.Err)
+}
+
+func (e *scriptError) Unwrap() error {
+	return e.Err
+}
+
+// runScript runs the statements of a script one by one.
+func runScript(ctx context.Context, client *sql.Client, database, version, direction, script string) error {
+	for i, statement := range sql.SplitStatements(script) {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement, Database: database}); err != nil {
+			return &scriptError{Version: version, Direction: direction, Statement: i + 1, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// diagnosticFromError keeps the summaries of the checks and names the failed statement of a script.
+func diagnosticFromError(err error) *util.SummaryWithDetailError {
+	var serr *util.SummaryWithDetailError
+	if errors.As(err, &serr) {
+		return serr
+	}
+
+	var scriptErr *scriptError
+	if errors.As(err, &scriptErr) {
+		serr := sql.DiagnosticFromError(scriptErr.Err)
+
+		return &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Migration %s failed", scriptErr.Version),
+			Detail: fmt.Sprintf("Statement %d of the %s script failed. %s: %s",
+				scriptErr.Statement, scriptErr.Direction, serr.Summary, serr.Detail,
+			),
+		}
+	}
+
+	return sql.DiagnosticFromError(err)
+}
+
+func createTrackingTableStatement(table string) string {
+	return fmt.Sprintf("CREATE ROWSTORE TABLE IF NOT EXISTS %s ("+
+		"version VARCHAR(255) NOT NULL PRIMARY KEY, "+
+		"checksum CHAR(64) NOT NULL, "+
+		"applied_at DATETIME(6) NOT NULL)",
+		sql.QuoteIdentifier(table),
+	)
+}
+
+// readApplied returns the recorded migrations in the order they were applied.
+// The result is empty if the tracking table does not exist.
+func readApplied(ctx context.Context, client *sql.Client, database, table string) ([]appliedMigration, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  "SELECT TABLE_NAME FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?",
+		Args: []any{database, table},
+	})
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+
+	rows, err = sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:      fmt.Sprintf("SELECT version, checksum FROM %s ORDER BY applied_at, version", sql.QuoteIdentifier(table)),
+		Database: database,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return util.Map(rows, func(row map[string]string) appliedMigration {
+		return appliedMigration{Version: row["version"], Checksum: row["checksum"]}
+	}), nil
+}
+
+func checksumsByVersion(appliedMigrations []appliedMigration) map[string]string {
+	result := make(map[string]string, len(appliedMigrations))
+	for _, m := range appliedMigrations {
+		result[m.Version] = m.Checksum
+	}
+
+	return result
+}
+
+// migrationChecksum is the SHA-256 checksum of the up script, which identifies an applied migration.
+func migrationChecksum(m migrationModel) string {
+	sum := sha256.Sum256([]byte(m.Up.ValueString()))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func listMigrations(ctx context.Context, list types.List) ([]migrationModel, diag.Diagnostics) {
+	var result []migrationModel
+	if list.IsNull() || list.IsUnknown() {
+		return result, nil
+	}
+
+	diags := list.ElementsAs(ctx, &result, false)
+
+	return result, diags
+}
+
+func migrationList(ctx context.Context, migrations []migrationModel) (types.List, diag.Diagnostics) {
+	if migrations == nil {
+		migrations = []migrationModel{}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: migrationAttrTypes}, migrations)
+}
+
+func versionList(migrations []migrationModel) types.List {
+	return types.ListValueMust(types.StringType, util.Map(migrations, func(m migrationModel) attr.Value { return m.Version }))
+}
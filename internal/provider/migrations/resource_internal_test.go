@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func migration(version, up string) migrationModel {
+	return migrationModel{Version: types.StringValue(version), Up: types.StringValue(up), Down: types.StringNull()}
+}
+
+func TestCheckChecksums(t *testing.T) {
+	migrations := []migrationModel{migration("1", "CREATE TABLE a (id INT)"), migration("2", "CREATE TABLE b (id INT)")}
+	applied := []appliedMigration{{Version: "1", Checksum: migrationChecksum(migrations[0])}}
+	require.NoError(t, checkChecksums(migrations, applied, "schema_migrations"))
+
+	applied = append(applied, appliedMigration{Version: "2", Checksum: migrationChecksum(migration("2", "CREATE TABLE b (id BIGINT)"))})
+	err := checkChecksums(migrations, applied, "schema_migrations")
+	require.Error(t, err)
+
+	serr := diagnosticFromError(err)
+	require.Equal(t, "Migration checksum mismatch", serr.Summary)
+	require.Contains(t, serr.Detail, "migration 2")
+}
+
+func TestDiagnosticFromScriptError(t *testing.T) {
+	err := &scriptError{Version: "3", Direction: "up", Statement: 2, Err: &sql.ExecError{Message: "Table 'a' already exists"}}
+
+	serr := diagnosticFromError(err)
+	require.Equal(t, "Migration 3 failed", serr.Summary)
+	require.Equal(t, "Statement 2 of the up script failed. SQL execution failed: Table 'a' already exists", serr.Detail)
+
+	require.Equal(t, "SingleStore Data API client call failed", diagnosticFromError(errors.New("boom")).Summary)
+}
+
+func TestMigrationChecksum(t *testing.T) {
+	require.Equal(t,
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		migrationChecksum(migration("1", "")),
+	)
+	require.NotEqual(t, migrationChecksum(migration("1", "SELECT 1")), migrationChecksum(migration("1", "SELECT 1 ")), "any change is tampering")
+}
@@ -0,0 +1,235 @@
+package migrations_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var trackingStatementRegexp = regexp.MustCompile("^(CREATE ROWSTORE TABLE IF NOT EXISTS|INSERT INTO|DELETE FROM|DROP TABLE IF EXISTS) `schema_migrations`")
+
+// fakeMigrations keeps the tracking table of a mock workspace and the migration statements that ran.
+type fakeMigrations struct {
+	*testutil.FakeWorkspace
+	table bool
+	rows  []map[string]any
+}
+
+func newFakeMigrations(t *testing.T) *fakeMigrations {
+	t.Helper()
+
+	w := &fakeMigrations{}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: trackingStatementRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				require.Equal(t, "app", req.Database)
+
+				switch m[1] {
+				case "CREATE ROWSTORE TABLE IF NOT EXISTS":
+					w.table = true
+				case "INSERT INTO":
+					w.rows = append(w.rows, map[string]any{"version": req.Args[0], "checksum": req.Args[1]})
+				case "DELETE FROM":
+					w.rows = slices.DeleteFunc(w.rows, func(row map[string]any) bool { return row["version"] == req.Args[0] })
+				case "DROP TABLE IF EXISTS":
+					w.table, w.rows = false, nil
+				}
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile("fail"),
+			Exec: func(_ testutil.DataAPIRequest, _ []string) error {
+				return fmt.Errorf("statement failed")
+			},
+		},
+		{
+			// The statements of the migrations.
+			Pattern: regexp.MustCompile(""),
+			Exec: func(req testutil.DataAPIRequest, _ []string) error {
+				require.Equal(t, "app", req.Database)
+				w.Record(req.SQL)
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile("^" + regexp.QuoteMeta("SELECT TABLE_NAME FROM information_schema.TABLES")),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				require.Equal(t, []any{"app", "schema_migrations"}, req.Args)
+				if !w.table {
+					return nil, nil
+				}
+
+				return []map[string]any{{"TABLE_NAME": "schema_migrations"}}, nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("SELECT version, checksum FROM `schema_migrations` ORDER BY applied_at, version"),
+			Query: func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				return slices.Clone(w.rows), nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeMigrations) tamper(version string) {
+	w.Do(func() {
+		for _, row := range w.rows {
+			if row["version"] == version {
+				row["checksum"] = "tampered"
+			}
+		}
+	})
+}
+
+// migrationsConfig lists the migrations 1 to n with down scripts.
+func migrationsConfig(n int, revertOnDestroy bool) string {
+	var migrations []string
+	for i := 1; i <= n; i++ {
+		migrations = append(migrations, fmt.Sprintf(`{
+      version = "%d"
+      up      = "CREATE TABLE t%d (id INT);\nINSERT INTO t%d VALUES (1);"
+      down    = "DROP TABLE t%d"
+    }`, i, i, i, i))
+	}
+
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_migrations" "this" {
+  endpoint          = %q
+  password          = "secret"
+  database          = "app"
+  revert_on_destroy = %t
+
+  migrations = [
+    %s,
+  ]
+}
+`, testutil.TestWorkspaceEndpoint, revertOnDestroy, strings.Join(migrations, ",\n    "))
+}
+
+func TestMigrationsApplyPendingAndRollBack(t *testing.T) {
+	w := newFakeMigrations(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: migrationsConfig(2, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_migrations.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/app/schema_migrations"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_migrations.this", "applied_versions.#", "2"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_migrations.this", "pending_versions.#", "0"),
+				),
+			},
+			{
+				Config: migrationsConfig(3, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_migrations.this", "applied_versions.2", "3"),
+				),
+			},
+			{
+				// Migrations removed from the list are rolled back, newest first.
+				Config: migrationsConfig(1, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_migrations.this", "applied_versions.#", "1"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE t1 (id INT)", "INSERT INTO t1 VALUES (1)",
+		"CREATE TABLE t2 (id INT)", "INSERT INTO t2 VALUES (1)",
+		"CREATE TABLE t3 (id INT)", "INSERT INTO t3 VALUES (1)",
+		"DROP TABLE t3",
+		"DROP TABLE t2",
+		"DROP TABLE t1",
+	}, w.Executed())
+}
+
+func TestMigrationsFailOnTampering(t *testing.T) {
+	w := newFakeMigrations(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: migrationsConfig(2, false),
+			},
+			{
+				PreConfig: func() {
+					w.tamper("1")
+				},
+				Config:      migrationsConfig(2, false),
+				ExpectError: regexp.MustCompile("Migration checksum mismatch"),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE t1 (id INT)", "INSERT INTO t1 VALUES (1)",
+		"CREATE TABLE t2 (id INT)", "INSERT INTO t2 VALUES (1)",
+	}, w.Executed(), "no statement runs after a mismatch, and destroy keeps the schema")
+}
+
+func TestMigrationsResumeAfterFailure(t *testing.T) {
+	w := newFakeMigrations(t)
+
+	failing := strings.Replace(migrationsConfig(2, false), "INSERT INTO t2", "INSERT INTO fail", 1)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      failing,
+				ExpectError: regexp.MustCompile(`Statement 2 of the up script failed`),
+			},
+			{
+				// The recorded migration 1 is skipped.
+				Config: migrationsConfig(1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_migrations.this", "applied_versions.#", "1"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE t1 (id INT)", "INSERT INTO t1 VALUES (1)",
+		"CREATE TABLE t2 (id INT)",
+	}, w.Executed())
+}
+
+func TestSQLMigrationsResourceIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.SQLMigrationsResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_migrations.this", "pending_versions.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,613 @@
+package pipelines
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "pipeline"
+
+	// stateRunning and stateError are the values of information_schema.PIPELINES.STATE
+	// that the resource acts on; a pipeline is otherwise Stopped.
+	stateRunning = "Running"
+	stateError   = "Error"
+
+	// maxReportedErrors is the number of the most recent errors exposed by the errors attribute.
+	maxReportedErrors = 10
+)
+
+var (
+	_ resource.Resource              = &pipelineResource{}
+	_ resource.ResourceWithConfigure = &pipelineResource{}
+
+	sourceTypes = []string{"KAFKA", "S3", "GCS", "AZURE", "FS"}
+
+	errorAttrTypes = map[string]attr.Type{
+		"unix_timestamp": types.StringType,
+		"type":           types.StringType,
+		"code":           types.Int64Type,
+		"message":        types.StringType,
+		"batch_id":       types.StringType,
+	}
+)
+
+type pipelineResourceModel struct {
+	sql.ConnectionModel
+	ID                    types.String `tfsdk:"id"`
+	Database              types.String `tfsdk:"database"`
+	Name                  types.String `tfsdk:"name"`
+	SourceType            types.String `tfsdk:"source_type"`
+	Source                types.String `tfsdk:"source"`
+	Config                types.String `tfsdk:"config"`
+	ConfigVersion         types.Int64  `tfsdk:"config_version"`
+	Credentials           types.String `tfsdk:"credentials"`
+	CredentialsVersion    types.Int64  `tfsdk:"credentials_version"`
+	IntoTable             types.String `tfsdk:"into_table"`
+	IntoProcedure         types.String `tfsdk:"into_procedure"`
+	LoadOptions           types.String `tfsdk:"load_options"`
+	BatchInterval         types.Int64  `tfsdk:"batch_interval"`
+	MaxPartitionsPerBatch types.Int64  `tfsdk:"max_partitions_per_batch"`
+	Running               types.Bool   `tfsdk:"running"`
+	State                 types.String `tfsdk:"state"`
+	Errors                types.List   `tfsdk:"errors"`
+}
+
+type pipelineErrorModel struct {
+	UnixTimestamp types.String `tfsdk:"unix_timestamp"`
+	Type          types.String `tfsdk:"type"`
+	Code          types.Int64  `tfsdk:"code"`
+	Message       types.String `tfsdk:"message"`
+	BatchID       types.String `tfsdk:"batch_id"`
+}
+
+// pipelineInfo is a row of information_schema.PIPELINES.
+type pipelineInfo struct {
+	State                 string
+	BatchInterval         *int64
+	MaxPartitionsPerBatch *int64
+}
+
+type pipelineResource struct {
+	sql.Connector
+}
+
+func NewResource() resource.Resource {
+	return &pipelineResource{}
+}
+
+func (r *pipelineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+func (r *pipelineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage an ingest pipeline of a SingleStore Helios workspace via the Data API. " +
+			"The 'apply' action creates the pipeline and starts or stops it according to `running`; changes to the definition " +
+			"replace the pipeline in place with `CREATE OR REPLACE PIPELINE`, which keeps the offsets loaded so far. " +
+			"The state and the most recent errors are read from `information_schema.PIPELINES` and `information_schema.PIPELINES_ERRORS`, " +
+			"and a pipeline stopped by an error is started again on the next apply.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the pipeline in the form `<endpoint>/<database>/<name>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The database of the pipeline and its target. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the pipeline. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"source_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The type of the source, one of %s.", util.Join(sourceTypes, ", ")),
+				Validators: []validator.String{
+					stringvalidator.OneOf(sourceTypes...),
+				},
+			},
+			"source": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The location of the data, e.g., `<broker>:9092/<topic>` for Kafka or `<bucket>/<prefix>` for S3.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"config": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "The JSON configuration of the source, e.g., `{\"region\": \"us-east-1\"}`. The configuration is write-only: it is not stored in the plan or the state, which requires Terraform 1.11 or later. " +
+					"A new value is only applied when `config_version` changes, and changes made outside of Terraform are not detected.",
+			},
+			"config_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of `config`. Changing this value replaces the definition of the pipeline with the current `config`. Requires `config`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("config")),
+				},
+			},
+			"credentials": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "The JSON credentials of the source. The credentials are write-only: they are not stored in the plan or the state, which requires Terraform 1.11 or later. " +
+					"A new value is only applied when `credentials_version` changes, and changes made outside of Terraform are not detected.",
+			},
+			"credentials_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of `credentials`. Changing this value replaces the definition of the pipeline with the current `credentials`. Requires `credentials`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("credentials")),
+				},
+			},
+			"into_table": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The table the pipeline loads into. Exactly one of `into_table` and `into_procedure` must be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("into_table"), path.MatchRoot("into_procedure")),
+				},
+			},
+			"into_procedure": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The stored procedure the pipeline passes the batches to. Exactly one of `into_table` and `into_procedure` must be set.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"load_options": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The clauses appended after the target as written in `CREATE PIPELINE`, e.g., `FORMAT JSON (id <- id, name <- name)` or `FIELDS TERMINATED BY ','`.",
+			},
+			"batch_interval": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The time to wait between the batches in milliseconds. Removing the value keeps the current setting.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_partitions_per_batch": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The maximum number of partitions that load a batch in parallel. Removing the value keeps the current setting.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"running": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether the pipeline runs (`START PIPELINE`) or is stopped (`STOP PIPELINE`). Defaults to `true`.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state of the pipeline as reported by `information_schema.PIPELINES`, e.g., `Running`, `Stopped`, or `Error`.",
+			},
+			"errors": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The %d most recent errors of the pipeline from `information_schema.PIPELINES_ERRORS`, newest first.", maxReportedErrors),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"unix_timestamp": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time of the error in seconds since the Unix epoch.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the error, e.g., `Error` or `Warning`.",
+						},
+						"code": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The error code.",
+						},
+						"message": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The error message.",
+						},
+						"batch_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The batch that failed.",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (r *pipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pipelineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(getWriteOnly(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if serr := execAll(ctx, client, plan.Database.ValueString(), []string{createPipelineStatement(plan, false)}); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Database.ValueString(), plan.Name.ValueString()))
+
+	if plan.Running.ValueBool() {
+		// The pipeline exists once it is created, so it is kept in the state as stopped
+		// when it fails to start rather than left behind outside of Terraform.
+		if serr := execAll(ctx, client, plan.Database.ValueString(), []string{"START PIPELINE " + sql.QuoteIdentifier(plan.Name.ValueString())}); serr != nil {
+			resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+			plan.Running = types.BoolValue(false)
+		}
+	}
+
+	resp.Diagnostics.Append(r.refreshAfterApply(ctx, client, &plan)...)
+
+	// The framework leaves the write-only values in the state when the apply fails, which Terraform rejects.
+	plan.Config, plan.Credentials = types.StringNull(), types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pipelineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	info, err := readPipeline(ctx, client, state.Database.ValueString(), state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if info == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, client, &state, *info, true)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *pipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state pipelineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(getWriteOnly(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if serr := execAll(ctx, client, plan.Database.ValueString(), updatePipelineStatements(plan, state)); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshAfterApply(ctx, client, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pipelineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{
+		SQL:      "DROP PIPELINE IF EXISTS " + sql.QuoteIdentifier(state.Name.ValueString()),
+		Database: state.Database.ValueString(),
+	}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *pipelineResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+// getWriteOnly reads config and credentials into the model. They are write-only, so they are only
+// available in the configuration, and the framework removes them from the state.
+func getWriteOnly(ctx context.Context, conf tfsdk.Config, model *pipelineResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(conf.GetAttribute(ctx, path.Root("config"), &model.Config)...)
+	diags.Append(conf.GetAttribute(ctx, path.Root("credentials"), &model.Credentials)...)
+
+	return diags
+}
+
+// refreshAfterApply reads the pipeline that was just created or updated into the model.
+func (r *pipelineResource) refreshAfterApply(ctx context.Context, client *sql.Client, model *pipelineResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	database, name := model.Database.ValueString(), model.Name.ValueString()
+
+	info, err := readPipeline(ctx, client, database, name)
+	if err == nil && info == nil {
+		err = fmt.Errorf("the pipeline %s is not listed in information_schema.PIPELINES of the database %s", name, database)
+	}
+
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		diags.AddError(serr.Summary, serr.Detail)
+
+		return diags
+	}
+
+	return r.refresh(ctx, client, model, *info, false)
+}
+
+// refresh reads the state and the errors of the pipeline into the model, given its row of information_schema.PIPELINES.
+// With detectDrift, the configurable attributes are updated too; after apply they keep the planned values, e.g.,
+// a pipeline that fails right after START PIPELINE is reported through state and errors and started again on the next apply.
+func (r *pipelineResource) refresh(ctx context.Context, client *sql.Client, model *pipelineResourceModel, info pipelineInfo, detectDrift bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	database, name := model.Database.ValueString(), model.Name.ValueString()
+
+	pipelineErrors, err := readPipelineErrors(ctx, client, database, name)
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		diags.AddError(serr.Summary, serr.Detail)
+
+		return diags
+	}
+
+	model.ConnectionModel = model.ForState()
+	model.State = types.StringValue(info.State)
+
+	if detectDrift {
+		model.Running = types.BoolValue(info.State == stateRunning)
+
+		// The batch settings are compared only when configured, since removing them keeps the current setting.
+		if !model.BatchInterval.IsNull() && info.BatchInterval != nil {
+			model.BatchInterval = types.Int64Value(*info.BatchInterval)
+		}
+
+		if !model.MaxPartitionsPerBatch.IsNull() && info.MaxPartitionsPerBatch != nil {
+			model.MaxPartitionsPerBatch = types.Int64Value(*info.MaxPartitionsPerBatch)
+		}
+	}
+
+	var d diag.Diagnostics
+	model.Errors, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: errorAttrTypes}, pipelineErrors)
+	diags.Append(d...)
+
+	if info.State == stateError {
+		detail := "The pipeline stopped because of an error."
+		if len(pipelineErrors) > 0 {
+			detail = fmt.Sprintf("The most recent error: %s", pipelineErrors[0].Message.ValueString())
+		}
+
+		diags.AddWarning(fmt.Sprintf("Pipeline %s is in the %s state", name, stateError), detail)
+	}
+
+	return diags
+}
+
+func execAll(ctx context.Context, client *sql.Client, database string, statements []string) *util.SummaryWithDetailError {
+	for _, statement := range statements {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement, Database: database}); err != nil {
+			return sql.DiagnosticFromError(err)
+		}
+	}
+
+	return nil
+}
+
+func createPipelineStatement(model pipelineResourceModel, replace bool) string {
+	var b strings.Builder
+
+	b.WriteString("CREATE ")
+	if replace {
+		b.WriteString("OR REPLACE ")
+	}
+
+	fmt.Fprintf(&b, "PIPELINE %s AS LOAD DATA %s %s",
+		sql.QuoteIdentifier(model.Name.ValueString()), model.SourceType.ValueString(), sql.QuoteString(model.Source.ValueString()),
+	)
+
+	if util.IsConfiguredString(model.Config) {
+		b.WriteString(" CONFIG " + sql.QuoteString(model.Config.ValueString()))
+	}
+
+	if util.IsConfiguredString(model.Credentials) {
+		b.WriteString(" CREDENTIALS " + sql.QuoteString(model.Credentials.ValueString()))
+	}
+
+	if interval := util.MaybeInt64(model.BatchInterval); interval != nil {
+		fmt.Fprintf(&b, " BATCH_INTERVAL %d", *interval)
+	}
+
+	if partitions := util.MaybeInt64(model.MaxPartitionsPerBatch); partitions != nil {
+		fmt.Fprintf(&b, " MAX_PARTITIONS_PER_BATCH %d", *partitions)
+	}
+
+	if util.IsConfiguredString(model.IntoProcedure) {
+		b.WriteString(" INTO PROCEDURE " + sql.QuoteIdentifier(model.IntoProcedure.ValueString()))
+	} else {
+		b.WriteString(" INTO TABLE " + sql.QuoteIdentifier(model.IntoTable.ValueString()))
+	}
+
+	if util.IsConfiguredString(model.LoadOptions) {
+		b.WriteString(" " + model.LoadOptions.ValueString())
+	}
+
+	return b.String()
+}
+
+// definitionChanged reports whether the pipeline has to be replaced with CREATE OR REPLACE PIPELINE.
+// The write-only config and credentials are compared through their versions.
+func definitionChanged(plan, state pipelineResourceModel) bool {
+	return !plan.SourceType.Equal(state.SourceType) ||
+		!plan.Source.Equal(state.Source) ||
+		!plan.ConfigVersion.Equal(state.ConfigVersion) ||
+		!plan.CredentialsVersion.Equal(state.CredentialsVersion) ||
+		!plan.IntoTable.Equal(state.IntoTable) ||
+		!plan.IntoProcedure.Equal(state.IntoProcedure) ||
+		!plan.LoadOptions.Equal(state.LoadOptions)
+}
+
+func updatePipelineStatements(plan, state pipelineResourceModel) []string {
+	var result []string
+
+	name := sql.QuoteIdentifier(plan.Name.ValueString())
+	running := state.Running.ValueBool()
+
+	if definitionChanged(plan, state) {
+		if running {
+			result = append(result, "STOP PIPELINE "+name)
+			running = false
+		}
+
+		result = append(result, createPipelineStatement(plan, true))
+	} else {
+		if interval := util.MaybeInt64(plan.BatchInterval); interval != nil && !plan.BatchInterval.Equal(state.BatchInterval) {
+			result = append(result, fmt.Sprintf("ALTER PIPELINE %s SET BATCH_INTERVAL %d", name, *interval))
+		}
+
+		if partitions := util.MaybeInt64(plan.MaxPartitionsPerBatch); partitions != nil && !plan.MaxPartitionsPerBatch.Equal(state.MaxPartitionsPerBatch) {
+			result = append(result, fmt.Sprintf("ALTER PIPELINE %s SET MAX_PARTITIONS_PER_BATCH %d", name, *partitions))
+		}
+	}
+
+	switch {
+	case plan.Running.ValueBool() && !running:
+		result = append(result, "START PIPELINE "+name)
+	case !plan.Running.ValueBool() && running:
+		result = append(result, "STOP PIPELINE "+name)
+	}
+
+	return result
+}
+
+// readPipeline returns nil if the pipeline does not exist.
+func readPipeline(ctx context.Context, client *sql.Client, database, name string) (*pipelineInfo, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  "SELECT STATE, CONFIG_JSON FROM information_schema.PIPELINES WHERE DATABASE_NAME = ? AND PIPELINE_NAME = ?",
+		Args: []any{database, name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	info := pipelineInfo{State: rows[0]["STATE"]}
+
+	// CONFIG_JSON lists the batch settings; settings that are not listed are not compared.
+	var settings struct {
+		BatchInterval         *int64 `json:"batch_interval"`
+		MaxPartitionsPerBatch *int64 `json:"max_partitions_per_batch"`
+	}
+	if err := json.Unmarshal([]byte(rows[0]["CONFIG_JSON"]), &settings); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("the batch settings of the pipeline %s are not compared, since its CONFIG_JSON could not be parsed: %s", name, err))
+
+		return &info, nil
+	}
+
+	info.BatchInterval = settings.BatchInterval
+	info.MaxPartitionsPerBatch = settings.MaxPartitionsPerBatch
+
+	return &info, nil
+}
+
+func readPipelineErrors(ctx context.Context, client *sql.Client, database, name string) ([]pipelineErrorModel, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL: "SELECT ERROR_UNIX_TIMESTAMP, ERROR_TYPE, ERROR_CODE, ERROR_MESSAGE, BATCH_ID " +
+			"FROM information_schema.PIPELINES_ERRORS WHERE DATABASE_NAME = ? AND PIPELINE_NAME = ? " +
+			"ORDER BY ERROR_UNIX_TIMESTAMP DESC LIMIT " + strconv.Itoa(maxReportedErrors),
+		Args: []any{database, name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]pipelineErrorModel, 0, len(rows))
+	for _, row := range rows {
+		code, _ := strconv.ParseInt(row["ERROR_CODE"], 10, 64) // Errors of the source may have no code.
+		result = append(result, pipelineErrorModel{
+			UnixTimestamp: types.StringValue(row["ERROR_UNIX_TIMESTAMP"]),
+			Type:          types.StringValue(row["ERROR_TYPE"]),
+			Code:          types.Int64Value(code),
+			Message:       types.StringValue(row["ERROR_MESSAGE"]),
+			BatchID:       types.StringValue(row["BATCH_ID"]),
+		})
+	}
+
+	return result, nil
+}
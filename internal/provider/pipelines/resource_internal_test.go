@@ -0,0 +1,84 @@
+package pipelines
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testPipelineModel() pipelineResourceModel {
+	return pipelineResourceModel{
+		Name:                  types.StringValue("orders"),
+		SourceType:            types.StringValue("S3"),
+		Source:                types.StringValue("my-bucket/orders/"),
+		Config:                types.StringValue(`{"region": "us-east-1"}`),
+		ConfigVersion:         types.Int64Value(1),
+		Credentials:           types.StringNull(),
+		CredentialsVersion:    types.Int64Null(),
+		IntoTable:             types.StringValue("orders"),
+		IntoProcedure:         types.StringNull(),
+		LoadOptions:           types.StringValue("FORMAT JSON (id <- id)"),
+		BatchInterval:         types.Int64Null(),
+		MaxPartitionsPerBatch: types.Int64Null(),
+		Running:               types.BoolValue(true),
+	}
+}
+
+func TestCreatePipelineStatement(t *testing.T) {
+	model := testPipelineModel()
+	require.Equal(t,
+		`CREATE PIPELINE `+"`orders`"+` AS LOAD DATA S3 'my-bucket/orders/' CONFIG '{"region": "us-east-1"}' INTO TABLE `+"`orders`"+` FORMAT JSON (id <- id)`,
+		createPipelineStatement(model, false),
+	)
+
+	model.Credentials = types.StringValue(`{"aws_secret_access_key": "it's"}`)
+	model.BatchInterval = types.Int64Value(1000)
+	model.MaxPartitionsPerBatch = types.Int64Value(4)
+	model.IntoTable = types.StringNull()
+	model.IntoProcedure = types.StringValue("load_orders")
+	model.LoadOptions = types.StringNull()
+	require.Equal(t,
+		`CREATE OR REPLACE PIPELINE `+"`orders`"+` AS LOAD DATA S3 'my-bucket/orders/' CONFIG '{"region": "us-east-1"}' `+
+			`CREDENTIALS '{"aws_secret_access_key": "it\'s"}' BATCH_INTERVAL 1000 MAX_PARTITIONS_PER_BATCH 4 INTO PROCEDURE `+"`load_orders`",
+		createPipelineStatement(model, true),
+	)
+}
+
+func TestUpdatePipelineStatements(t *testing.T) {
+	state := testPipelineModel()
+	require.Empty(t, updatePipelineStatements(state, state))
+
+	plan := state
+	plan.Running = types.BoolValue(false)
+	plan.BatchInterval = types.Int64Value(1000)
+	require.Equal(t, []string{
+		"ALTER PIPELINE `orders` SET BATCH_INTERVAL 1000",
+		"STOP PIPELINE `orders`",
+	}, updatePipelineStatements(plan, state))
+
+	// A running pipeline is stopped while its definition is replaced.
+	plan = state
+	plan.Source = types.StringValue("my-bucket/orders-v2/")
+	require.Equal(t, []string{
+		"STOP PIPELINE `orders`",
+		createPipelineStatement(plan, true),
+		"START PIPELINE `orders`",
+	}, updatePipelineStatements(plan, state))
+
+	// The write-only configuration is only applied when its version changes.
+	plan = state
+	plan.Config = types.StringValue(`{"region": "us-west-2"}`)
+	require.Empty(t, updatePipelineStatements(plan, state))
+
+	plan.ConfigVersion = types.Int64Value(2)
+	require.Equal(t, []string{
+		"STOP PIPELINE `orders`",
+		createPipelineStatement(plan, true),
+		"START PIPELINE `orders`",
+	}, updatePipelineStatements(plan, state))
+
+	// A pipeline stopped by an error is started again.
+	state.Running = types.BoolValue(false)
+	require.Equal(t, []string{"START PIPELINE `orders`"}, updatePipelineStatements(testPipelineModel(), state))
+}
@@ -0,0 +1,229 @@
+package pipelines_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var pipelineStatementRegexp = regexp.MustCompile("^(CREATE PIPELINE|CREATE OR REPLACE PIPELINE|START PIPELINE|STOP PIPELINE|DROP PIPELINE IF EXISTS) `orders`")
+
+// fakePipelines keeps the state of the pipeline `orders` of a mock workspace.
+type fakePipelines struct {
+	*testutil.FakeWorkspace
+	state      string
+	definition string
+	errors     []map[string]any
+	// startError fails START PIPELINE when set.
+	startError string
+}
+
+func newFakePipelines(t *testing.T) *fakePipelines {
+	t.Helper()
+
+	w := &fakePipelines{}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: pipelineStatementRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				require.Equal(t, "my_app_db", req.Database)
+
+				w.Record(m[1])
+
+				if m[1] == "START PIPELINE" && w.startError != "" {
+					return errors.New(w.startError)
+				}
+
+				switch m[1] {
+				case "CREATE PIPELINE", "CREATE OR REPLACE PIPELINE":
+					w.state = "Stopped"
+					w.definition = req.SQL
+				case "START PIPELINE":
+					w.state = "Running"
+				case "STOP PIPELINE":
+					w.state = "Stopped"
+				default:
+					w.state = ""
+				}
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile(`information_schema\.PIPELINES_ERRORS\b`),
+			Query: func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				return w.errors, nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile(`information_schema\.PIPELINES\b`),
+			Query: func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				if w.state == "" {
+					return nil, nil
+				}
+
+				return []map[string]any{{"STATE": w.state, "CONFIG_JSON": `{"batch_interval": 2500}`}}, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakePipelines) failOutsideTerraform(message string) {
+	w.Do(func() {
+		w.state = "Error"
+		w.errors = []map[string]any{{
+			"ERROR_UNIX_TIMESTAMP": "1760000000.5",
+			"ERROR_TYPE":           "Error",
+			"ERROR_CODE":           1261,
+			"ERROR_MESSAGE":        message,
+			"BATCH_ID":             7,
+		}}
+	})
+}
+
+func (w *fakePipelines) checkDefinition(substr string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var definition string
+		w.Do(func() {
+			definition = w.definition
+		})
+
+		if !strings.Contains(definition, substr) {
+			return fmt.Errorf("the definition %q should contain %q", definition, substr)
+		}
+
+		return nil
+	}
+}
+
+func pipelineConfig(running bool, source, region string, configVersion int) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_pipeline" "this" {
+  endpoint       = %q
+  password       = "secret"
+  database       = "my_app_db"
+  name           = "orders"
+  source_type    = "S3"
+  source         = %q
+  config         = jsonencode({ region = %q })
+  config_version = %d
+  credentials    = jsonencode({})
+  into_table     = "orders"
+  batch_interval = 2500
+  running        = %t
+}
+`, testutil.TestWorkspaceEndpoint, source, region, configVersion, running)
+}
+
+func TestCRUDPipeline(t *testing.T) {
+	w := newFakePipelines(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: pipelineConfig(true, "my-bucket/orders/", "us-east-1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/my_app_db/orders"),
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "state", "Running"),
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "errors.#", "0"),
+					resource.TestCheckNoResourceAttr("singlestoredb_pipeline.this", "config"),
+					resource.TestCheckNoResourceAttr("singlestoredb_pipeline.this", "credentials"),
+					w.checkDefinition(`CONFIG '{"region":"us-east-1"}' CREDENTIALS '{}'`),
+				),
+			},
+			{
+				// The errors of a pipeline that failed outside of Terraform are exposed, and the pipeline is started again.
+				PreConfig: func() {
+					w.failOutsideTerraform("Cannot access bucket my-bucket")
+				},
+				Config: pipelineConfig(true, "my-bucket/orders/", "us-east-1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "state", "Running"),
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "errors.#", "1"),
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "errors.0.code", "1261"),
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "errors.0.message", "Cannot access bucket my-bucket"),
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "errors.0.batch_id", "7"),
+				),
+			},
+			{
+				// The write-only configuration is not compared, so it is only applied when its version changes.
+				Config:   pipelineConfig(true, "my-bucket/orders/", "us-west-2", 1),
+				PlanOnly: true,
+			},
+			{
+				Config: pipelineConfig(false, "my-bucket/orders-v2/", "us-west-2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "state", "Stopped"),
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "running", "false"),
+					w.checkDefinition(`CONFIG '{"region":"us-west-2"}'`),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE PIPELINE",
+		"START PIPELINE",
+		"START PIPELINE",
+		"STOP PIPELINE",
+		"CREATE OR REPLACE PIPELINE",
+		"DROP PIPELINE IF EXISTS",
+	}, w.Executed())
+}
+
+func TestCreatePipelineKeepsPipelineThatFailsToStart(t *testing.T) {
+	w := newFakePipelines(t)
+	w.startError = "Cannot access bucket my-bucket"
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      pipelineConfig(true, "my-bucket/orders/", "us-east-1", 1),
+				ExpectError: regexp.MustCompile("Cannot access bucket my-bucket"),
+			},
+		},
+	})
+
+	// The pipeline is in the state, so that destroy drops it.
+	require.Equal(t, []string{
+		"CREATE PIPELINE",
+		"START PIPELINE",
+		"DROP PIPELINE IF EXISTS",
+	}, w.Executed())
+}
+
+func TestPipelineIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.PipelineResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_pipeline.this", "name", "orders"),
+					resource.TestCheckResourceAttrSet("singlestoredb_pipeline.this", "state"),
+				),
+			},
+		},
+	})
+}
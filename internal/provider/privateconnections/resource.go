@@ -2,6 +2,7 @@ package privateconnections
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -203,6 +204,17 @@ func (r *privateConnectionResource) Create(ctx context.Context, req resource.Cre
 		websocketPort = util.MaybeFloat32(plan.WebsocketsPort)
 	}
 
+	unlock, lerr := util.LockWorkspaceGroup(ctx, plan.WorkspaceGroupID.ValueString(), createOperation(&plan))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
+
 	privateConnectionCreateResponse, err := r.PostV1PrivateConnectionsWithResponse(ctx, management.PostV1PrivateConnectionsJSONRequestBody{
 		AllowList:        util.MaybeString(plan.AllowList),
 		KaiEndpointID:    util.MaybeString(plan.KaiEndpointID),
@@ -224,12 +236,14 @@ func (r *privateConnectionResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	id := privateConnectionCreateResponse.JSON200.PrivateConnectionID
+	resp.Diagnostics.Append(util.SavePendingCreate(ctx, req, resp, id.String())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	con, werr := WaitPrivateConnectionStatus(ctx, r.ClientWithResponsesInterface, id, waitConditionStatus(management.PrivateConnectionStatusACTIVE))
 	if werr != nil {
-		resp.Diagnostics.AddError(
-			werr.Summary,
-			werr.Detail,
-		)
+		util.AddCreateWaitDiagnostic(ctx, &resp.Diagnostics, "private connection", id.String(), werr)
 
 		return
 	}
@@ -244,6 +258,7 @@ func (r *privateConnectionResource) Create(ctx context.Context, req resource.Cre
 
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -314,11 +329,50 @@ func (r *privateConnectionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	id := uuid.MustParse(plan.ID.ValueString())
+
+	if util.IsCreatePending(ctx, req.Private) {
+		con, werr := WaitPrivateConnectionStatus(ctx, r.ClientWithResponsesInterface, id, waitConditionStatus(management.PrivateConnectionStatusACTIVE))
+		if werr != nil {
+			resp.Diagnostics.AddError(
+				werr.Summary,
+				werr.Detail,
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
+
+		if plan.AllowList.Equal(state.AllowList) {
+			result, terr := toPrivateConnectionModel(con)
+			if terr != nil {
+				resp.Diagnostics.AddError(terr.Summary, terr.Detail)
+
+				return
+			}
+
+			diags = resp.State.Set(ctx, &result)
+			resp.Diagnostics.Append(diags...)
+
+			return
+		}
+	}
+
 	if plan.AllowList.Equal(state.AllowList) {
 		return
 	}
 
-	id := uuid.MustParse(plan.ID.ValueString())
+	unlock, lerr := util.LockWorkspaceGroup(ctx, state.WorkspaceGroupID.ValueString(), fmt.Sprintf("Updating the private connection %s", id))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
 
 	privateConnectionUpdateResponse, err := r.PatchV1PrivateConnectionsConnectionIDWithResponse(ctx, id,
 		management.PrivateConnectionUpdate{
@@ -368,6 +422,17 @@ func (r *privateConnectionResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	unlock, lerr := util.LockWorkspaceGroup(ctx, state.WorkspaceGroupID.ValueString(), fmt.Sprintf("Deleting the private connection %s", state.ID.ValueString()))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
+
 	privateConnectionDeleteResponse, err := r.DeleteV1PrivateConnectionsConnectionIDWithResponse(ctx,
 		uuid.MustParse(state.ID.ValueString()),
 	)
@@ -395,14 +460,20 @@ func (r *privateConnectionResource) ModifyPlan(ctx context.Context, req resource
 	var state *PrivateConnectionModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || state == nil {
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	var plan *PrivateConnectionModel
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || plan == nil {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planWorkspaceGroupOperation(ctx, req, plan, state)
+
+	if state == nil || plan == nil {
 		return
 	}
 
@@ -415,6 +486,35 @@ func (r *privateConnectionResource) ModifyPlan(ctx context.Context, req resource
 
 		return
 	}
+
+	util.PlanPendingCreate(ctx, req, resp, "created_at", "active_at", "updated_at", "endpoint", "outbound_allow_list", "status")
+}
+
+// createOperation names the creation of the private connection, which has no ID yet, by what it connects to.
+func createOperation(model *PrivateConnectionModel) string {
+	switch {
+	case util.IsConfiguredString(model.ServiceName):
+		return fmt.Sprintf("Creating the private connection to the service %s", model.ServiceName.ValueString())
+	case util.IsConfiguredString(model.WorkspaceID):
+		return fmt.Sprintf("Creating a private connection to the workspace %s", model.WorkspaceID.ValueString())
+	case util.IsConfiguredString(model.AllowList):
+		return fmt.Sprintf("Creating a private connection for the allow list %s", model.AllowList.ValueString())
+	default:
+		return "Creating a private connection"
+	}
+}
+
+// planWorkspaceGroupOperation logs at plan time when the planned create, update, or delete
+// will be serialized with other operations in the workspace group during apply.
+func planWorkspaceGroupOperation(ctx context.Context, req resource.ModifyPlanRequest, plan, state *PrivateConnectionModel) {
+	switch {
+	case state == nil && plan != nil:
+		util.PlanWorkspaceGroupOperation(ctx, plan.WorkspaceGroupID, createOperation(plan))
+	case plan == nil && state != nil:
+		util.PlanWorkspaceGroupOperation(ctx, state.WorkspaceGroupID, fmt.Sprintf("Deleting the private connection %s", state.ID.ValueString()))
+	case plan != nil && !req.Plan.Raw.Equal(req.State.Raw):
+		util.PlanWorkspaceGroupOperation(ctx, state.WorkspaceGroupID, fmt.Sprintf("Updating the private connection %s", state.ID.ValueString()))
+	}
 }
 
 // ImportState results in Terraform managing the resource that was not previously managed.
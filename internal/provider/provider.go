@@ -14,14 +14,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/databases"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/flow"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/globalvariables"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/invitations"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/links"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/migrations"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/pipelines"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/privateconnections"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/projects"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/regions"
 	regions_v2 "github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/regionsv2"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/resourcepools"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/roles"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sqlobjects"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sqlusers"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/tables"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/teams"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/users"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
@@ -31,7 +40,8 @@ import (
 
 // singlestoreProvider is the provider implementation.
 type singlestoreProvider struct {
-	version string
+	version           string
+	dataAPIHTTPClient func() *http.Client
 }
 
 // singlestoreProviderModel maps provider schema data to a Go type.
@@ -46,11 +56,27 @@ var (
 	_ provider.ProviderWithValidateConfig = &singlestoreProvider{}
 )
 
-func New(version string) func() provider.Provider {
+// Option configures the provider.
+type Option func(*singlestoreProvider)
+
+// WithDataAPIHTTPClient makes the resources and data sources that run SQL create the HTTP clients
+// of the Data API with newHTTPClient instead of util.NewHTTPClient, e.g., to serve them with a mock server.
+func WithDataAPIHTTPClient(newHTTPClient func() *http.Client) Option {
+	return func(p *singlestoreProvider) {
+		p.dataAPIHTTPClient = newHTTPClient
+	}
+}
+
+func New(version string, opts ...Option) func() provider.Provider {
 	return func() provider.Provider {
-		return &singlestoreProvider{
+		p := &singlestoreProvider{
 			version: version,
 		}
+		for _, opt := range opts {
+			opt(p)
+		}
+
+		return p
 	}
 }
 
@@ -152,10 +178,17 @@ func (p *singlestoreProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
+	// The provider is configured at the start of every plan and apply.
+	util.ResetPlannedWorkspaceGroupOperations()
+
 	// Make the SingleStore client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	data := &util.ProviderData{
+		ClientWithResponsesInterface: client,
+		DataAPIHTTPClient:            p.dataAPIHTTPClient,
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -202,6 +235,23 @@ func (p *singlestoreProvider) Resources(_ context.Context) []func() resource.Res
 		flow.NewResource,
 		projects.NewResource,
 		sql.NewResource,
+		sql.NewReadyResource,
+		databases.NewResource,
+		databases.NewAttachmentResource,
+		sqlusers.NewUserResource,
+		sqlusers.NewGrantResource,
+		sqlusers.NewRoleResource,
+		sqlusers.NewGroupResource,
+		sqlusers.NewMembershipResource,
+		pipelines.NewResource,
+		resourcepools.NewResource,
+		globalvariables.NewResource,
+		links.NewResource,
+		sqlobjects.NewProcedureResource,
+		sqlobjects.NewFunctionResource,
+		sqlobjects.NewViewResource,
+		migrations.NewResource,
+		tables.NewResource,
 	}
 }
 
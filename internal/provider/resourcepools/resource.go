@@ -0,0 +1,394 @@
+package resourcepools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "resource_pool"
+)
+
+var (
+	_ resource.Resource                = &resourcePoolResource{}
+	_ resource.ResourceWithConfigure   = &resourcePoolResource{}
+	_ resource.ResourceWithImportState = &resourcePoolResource{}
+)
+
+type resourcePoolResourceModel struct {
+	sql.ConnectionModel
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	MemoryPercentage       types.Int64  `tfsdk:"memory_percentage"`
+	SoftCPULimitPercentage types.Int64  `tfsdk:"soft_cpu_limit_percentage"`
+	HardCPULimitPercentage types.Int64  `tfsdk:"hard_cpu_limit_percentage"`
+	MaxConcurrency         types.Int64  `tfsdk:"max_concurrency"`
+	MaxQueueDepth          types.Int64  `tfsdk:"max_queue_depth"`
+	QueryTimeout           types.Int64  `tfsdk:"query_timeout"`
+}
+
+// setting is a limit of a resource pool, which is both an option of CREATE RESOURCE POOL
+// and a column of information_schema.RESOURCE_POOLS.
+type setting struct {
+	Attribute   string
+	Option      string
+	Description string
+	Min, Max    int64
+}
+
+var settings = []setting{
+	{
+		Attribute:   "memory_percentage",
+		Option:      "MEMORY_PERCENTAGE",
+		Description: "The percentage of the memory of the workspace that the queries of the pool can use.",
+		Min:         1,
+		Max:         100,
+	},
+	{
+		Attribute:   "soft_cpu_limit_percentage",
+		Option:      "SOFT_CPU_LIMIT_PERCENTAGE",
+		Description: "The percentage of the CPU that the queries of the pool can use when other pools compete for it.",
+		Min:         1,
+		Max:         100,
+	},
+	{
+		Attribute:   "hard_cpu_limit_percentage",
+		Option:      "HARD_CPU_LIMIT_PERCENTAGE",
+		Description: "The percentage of the CPU that the queries of the pool can never exceed.",
+		Min:         1,
+		Max:         100,
+	},
+	{
+		Attribute:   "max_concurrency",
+		Option:      "MAX_CONCURRENCY",
+		Description: "The maximum number of queries of the pool that run at the same time; `0` means unlimited.",
+		Min:         0,
+	},
+	{
+		Attribute:   "max_queue_depth",
+		Option:      "MAX_QUEUE_DEPTH",
+		Description: "The maximum number of queries of the pool that wait for `max_concurrency`; `0` means unlimited.",
+		Min:         0,
+	},
+	{
+		Attribute:   "query_timeout",
+		Option:      "QUERY_TIMEOUT",
+		Description: "The number of seconds after which the queries of the pool are canceled; `0` means no timeout.",
+		Min:         0,
+	},
+}
+
+// values returns the values of the settings in the order of settings.
+func (m *resourcePoolResourceModel) values() []*types.Int64 {
+	return []*types.Int64{
+		&m.MemoryPercentage,
+		&m.SoftCPULimitPercentage,
+		&m.HardCPULimitPercentage,
+		&m.MaxConcurrency,
+		&m.MaxQueueDepth,
+		&m.QueryTimeout,
+	}
+}
+
+type resourcePoolResource struct {
+	sql.Connector
+}
+
+func NewResource() resource.Resource {
+	return &resourcePoolResource{}
+}
+
+func (r *resourcePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+func (r *resourcePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		config.IDAttribute: schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The identifier of the resource pool in the form `<endpoint>/<name>`.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The name of the resource pool. Changing this value forces replacement.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 64),
+			},
+		},
+	}
+
+	for _, s := range settings {
+		validators := []validator.Int64{int64validator.AtLeast(s.Min)}
+		if s.Max > 0 {
+			validators = []validator.Int64{int64validator.Between(s.Min, s.Max)}
+		}
+
+		attributes[s.Attribute] = schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: s.Description + " When unset, the workspace default applies. Removing the value keeps the current setting.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: validators,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a resource pool of a SingleStore Helios workspace via the Data API. " +
+			"A resource pool limits the memory, the CPU, and the concurrency of the queries that run in it, e.g., to isolate BI queries from ingest. " +
+			"Users run in a pool set with `singlestoredb_sql_user.resource_pool` or `SET resource_pool`. " +
+			"Changes made outside of Terraform are detected through `information_schema.RESOURCE_POOLS`.",
+		Attributes: sql.WithConnectionAttributes(attributes),
+	}
+}
+
+func (r *resourcePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourcePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: createResourcePoolStatement(plan)}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(r.readAfterApply(ctx, client, plan, &resp.State, "created")...)
+}
+
+func (r *resourcePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourcePoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	values, err := readResourcePool(ctx, client, state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if values == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, toResourcePoolResourceModel(state, values))...)
+}
+
+func (r *resourcePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourcePoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if statement := alterResourcePoolStatement(plan, state); statement != "" {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement}); err != nil {
+			serr := sql.DiagnosticFromError(err)
+			resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readAfterApply(ctx, client, plan, &resp.State, "updated")...)
+}
+
+func (r *resourcePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state resourcePoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	values, err := readResourcePool(ctx, client, state.Name.ValueString())
+	if err == nil && values != nil {
+		_, err = client.Exec(ctx, sql.ExecRequest{SQL: "DROP RESOURCE POOL " + sql.QuoteIdentifier(state.Name.ValueString())})
+	}
+
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *resourcePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *resourcePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := sql.ImportConnection(ctx, req, resp, "`<endpoint>/<name>`", 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), names[0])...)
+}
+
+// readAfterApply saves the settings reported by the workspace, which fills the settings that are not configured.
+func (r *resourcePoolResource) readAfterApply(ctx context.Context, client *sql.Client, plan resourcePoolResourceModel, state *tfsdk.State, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values, err := readResourcePool(ctx, client, plan.Name.ValueString())
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		diags.AddError(serr.Summary, serr.Detail)
+
+		return diags
+	}
+
+	if values == nil {
+		diags.AddError(
+			"Resource pool not found",
+			fmt.Sprintf("The resource pool %s is not listed in information_schema.RESOURCE_POOLS after it was %s. %s",
+				plan.Name.ValueString(), action, config.CreateProviderIssueErrorDetail,
+			),
+		)
+
+		return diags
+	}
+
+	return state.Set(ctx, toResourcePoolResourceModel(plan, values))
+}
+
+func createResourcePoolStatement(model resourcePoolResourceModel) string {
+	var options []string
+	for i, value := range model.values() {
+		if v := util.MaybeInt64(*value); v != nil {
+			options = append(options, fmt.Sprintf("%s = %d", settings[i].Option, *v))
+		}
+	}
+
+	statement := "CREATE RESOURCE POOL " + sql.QuoteIdentifier(model.Name.ValueString())
+	if len(options) > 0 {
+		statement += " WITH " + strings.Join(options, ", ")
+	}
+
+	return statement
+}
+
+// alterResourcePoolStatement returns an empty string if no configured setting changed.
+func alterResourcePoolStatement(plan, state resourcePoolResourceModel) string {
+	planValues, stateValues := plan.values(), state.values()
+
+	var options []string
+	for i, value := range planValues {
+		if v := util.MaybeInt64(*value); v != nil && !value.Equal(*stateValues[i]) {
+			options = append(options, fmt.Sprintf("%s = %d", settings[i].Option, *v))
+		}
+	}
+
+	if len(options) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("ALTER RESOURCE POOL %s SET %s", sql.QuoteIdentifier(plan.Name.ValueString()), strings.Join(options, ", "))
+}
+
+// readResourcePool returns the settings in the order of settings, or nil if the resource pool does not exist.
+// Settings that are not limited are returned as nil.
+func readResourcePool(ctx context.Context, client *sql.Client, name string) ([]*int64, error) {
+	columns := util.Map(settings, func(s setting) string { return s.Option })
+
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  fmt.Sprintf("SELECT %s FROM information_schema.RESOURCE_POOLS WHERE POOL_NAME = ?", strings.Join(columns, ", ")),
+		Args: []any{name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	result := make([]*int64, len(columns))
+	for i, column := range columns {
+		value := rows[0][column]
+		if value == "" {
+			continue
+		}
+
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s of the resource pool %s: %w", column, name, err)
+		}
+
+		result[i] = &v
+	}
+
+	return result, nil
+}
+
+func toResourcePoolResourceModel(model resourcePoolResourceModel, values []*int64) resourcePoolResourceModel {
+	model.ConnectionModel = model.ForState()
+	for i, value := range model.values() {
+		*value = types.Int64PointerValue(values[i])
+	}
+
+	return model
+}
@@ -0,0 +1,48 @@
+package resourcepools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcePoolStatements(t *testing.T) {
+	model := resourcePoolResourceModel{
+		Name:                   types.StringValue("bi"),
+		MemoryPercentage:       types.Int64Value(40),
+		SoftCPULimitPercentage: types.Int64Unknown(),
+		HardCPULimitPercentage: types.Int64Unknown(),
+		MaxConcurrency:         types.Int64Value(10),
+		MaxQueueDepth:          types.Int64Unknown(),
+		QueryTimeout:           types.Int64Value(60),
+	}
+	require.Equal(t,
+		"CREATE RESOURCE POOL `bi` WITH MEMORY_PERCENTAGE = 40, MAX_CONCURRENCY = 10, QUERY_TIMEOUT = 60",
+		createResourcePoolStatement(model),
+	)
+	require.Equal(t, "CREATE RESOURCE POOL `bi`", createResourcePoolStatement(resourcePoolResourceModel{Name: types.StringValue("bi")}))
+
+	state := model
+	state.SoftCPULimitPercentage = types.Int64Value(100)
+	state.HardCPULimitPercentage = types.Int64Null()
+	state.MaxQueueDepth = types.Int64Value(0)
+	require.Empty(t, alterResourcePoolStatement(state, state))
+
+	plan := state
+	plan.MemoryPercentage = types.Int64Value(50)
+	plan.QueryTimeout = types.Int64Value(120)
+	require.Equal(t,
+		"ALTER RESOURCE POOL `bi` SET MEMORY_PERCENTAGE = 50, QUERY_TIMEOUT = 120",
+		alterResourcePoolStatement(plan, state),
+	)
+}
+
+func TestToResourcePoolResourceModel(t *testing.T) {
+	forty, zero := int64(40), int64(0)
+
+	result := toResourcePoolResourceModel(resourcePoolResourceModel{}, []*int64{&forty, nil, nil, &zero, nil, nil})
+	require.Equal(t, types.Int64Value(40), result.MemoryPercentage)
+	require.True(t, result.HardCPULimitPercentage.IsNull())
+	require.Equal(t, types.Int64Value(0), result.MaxConcurrency)
+}
@@ -0,0 +1,163 @@
+package resourcepools_test
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	poolStatementRegexp = regexp.MustCompile("^(CREATE|ALTER|DROP) RESOURCE POOL `bi`(?: WITH | SET )?(.*)$")
+	poolOptionRegexp    = regexp.MustCompile(`(\w+) = (\d+)`)
+)
+
+// fakeResourcePools keeps the settings of the resource pool `bi` of a mock workspace.
+type fakeResourcePools struct {
+	*testutil.FakeWorkspace
+	settings map[string]any
+}
+
+func newFakeResourcePools(t *testing.T) *fakeResourcePools {
+	t.Helper()
+
+	w := &fakeResourcePools{}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: poolStatementRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				w.Record(req.SQL)
+
+				switch m[1] {
+				case "CREATE":
+					// The workspace defaults.
+					w.settings = map[string]any{
+						"MEMORY_PERCENTAGE":         100,
+						"SOFT_CPU_LIMIT_PERCENTAGE": 100,
+						"HARD_CPU_LIMIT_PERCENTAGE": nil,
+						"MAX_CONCURRENCY":           0,
+						"MAX_QUEUE_DEPTH":           0,
+						"QUERY_TIMEOUT":             nil,
+					}
+				case "DROP":
+					w.settings = nil
+
+					return nil
+				}
+
+				for _, option := range poolOptionRegexp.FindAllStringSubmatch(m[2], -1) {
+					w.settings[option[1]] = option[2]
+				}
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile(regexp.QuoteMeta("FROM information_schema.RESOURCE_POOLS WHERE POOL_NAME = ?") + "$"),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				if w.settings == nil || req.Args[0] != "bi" {
+					return nil, nil
+				}
+
+				return []map[string]any{maps.Clone(w.settings)}, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeResourcePools) alterOutsideTerraform(option string, value int) {
+	w.Do(func() {
+		w.settings[option] = value
+	})
+}
+
+func resourcePoolConfig(memory int) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_resource_pool" "this" {
+  endpoint          = %q
+  password          = "secret"
+  name              = "bi"
+  memory_percentage = %d
+  max_concurrency   = 10
+  query_timeout     = 60
+}
+`, testutil.TestWorkspaceEndpoint, memory)
+}
+
+func TestCRUDResourcePool(t *testing.T) {
+	w := newFakeResourcePools(t)
+	t.Setenv(config.EnvSQLUserPassword, "secret") // The imported resource pool reads the password from the environment.
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: resourcePoolConfig(40),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/bi"),
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.this", "memory_percentage", "40"),
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.this", "soft_cpu_limit_percentage", "100"),
+					resource.TestCheckNoResourceAttr("singlestoredb_resource_pool.this", "hard_cpu_limit_percentage"),
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.this", "max_concurrency", "10"),
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.this", "query_timeout", "60"),
+				),
+			},
+			{
+				ResourceName:            "singlestoredb_resource_pool.this",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/bi",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				// The limit changed outside of Terraform is set again along with the changed one.
+				PreConfig: func() {
+					w.alterOutsideTerraform("MAX_CONCURRENCY", 50)
+				},
+				Config: resourcePoolConfig(50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.this", "memory_percentage", "50"),
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.this", "max_concurrency", "10"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE RESOURCE POOL `bi` WITH MEMORY_PERCENTAGE = 40, MAX_CONCURRENCY = 10, QUERY_TIMEOUT = 60",
+		"ALTER RESOURCE POOL `bi` SET MEMORY_PERCENTAGE = 50, MAX_CONCURRENCY = 10",
+		"DROP RESOURCE POOL `bi`",
+	}, w.Executed())
+}
+
+func TestResourcePoolIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.ResourcePoolResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_resource_pool.bi", "memory_percentage", "40"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.analyst", "resource_pool", "bi"),
+				),
+			},
+		},
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 )
 
@@ -17,22 +18,22 @@ const (
 	// MaxRequestBodyBytes is the Data API request body size limit.
 	MaxRequestBodyBytes = 1 << 20
 
-	execPath      = "/api/v2/exec"
-	queryRowsPath = "/api/v2/query/rows"
+	execPath        = "/api/v2/exec"
+	queryTuplesPath = "/api/v2/query/tuples"
 )
 
-var httpClientFactory = util.NewHTTPClient
-
-// Client calls the SingleStore Data API over HTTPS.
+// Client calls the SingleStore Data API over HTTPS, or sends the same requests over the MySQL protocol.
 type Client struct {
 	httpClient *http.Client
 	baseURL    string
 	host       string
 	username   string
 	password   string
+	// mysqlConfig is set when the client uses the MySQL protocol instead of the Data API.
+	mysqlConfig *mysql.Config
 }
 
-// ExecRequest is the JSON body for /exec and /query/rows.
+// ExecRequest is the JSON body for /exec and /query/tuples.
 type ExecRequest struct {
 	SQL      string `json:"sql"`
 	Args     []any  `json:"args,omitempty"`
@@ -52,18 +53,44 @@ type ExecResponse struct {
 	Error        *apiErrorBody `json:"error,omitempty"`
 }
 
-// QueryRowsResponse is the JSON body from /api/v2/query/rows.
+// QueryRowsResponse is the result of a query. The Data API sends the rows of
+// /api/v2/query/tuples as arrays, which are converted to maps keyed by column name.
 type QueryRowsResponse struct {
-	Results []struct {
-		Rows []map[string]any `json:"rows"`
-	} `json:"results"`
-	Error *apiErrorBody `json:"error,omitempty"`
+	Results []QueryResultSet `json:"results"`
+	Error   *apiErrorBody    `json:"error,omitempty"`
+	// Truncated is set when rows were dropped because of the QueryLimits.
+	Truncated bool `json:"-"`
+}
+
+// QueryLimits bound the rows a query returns. Zero means no limit.
+type QueryLimits struct {
+	// MaxRows is the number of rows across all result sets.
+	MaxRows int
+	// MaxBytes is the size of the rows as encoded in the response.
+	MaxBytes int64
+}
+
+// QueryResultSet is one result set of a query response.
+type QueryResultSet struct {
+	Columns []QueryColumn    `json:"columns"`
+	Rows    []map[string]any `json:"rows"`
+}
+
+// QueryColumn describes a column of a result set in select-list order.
+type QueryColumn struct {
+	Name     string `json:"name"`
+	DataType string `json:"dataType"`
+	Nullable bool   `json:"nullable"`
 }
 
 // NewClient creates a Data API client for the given base URL and credentials.
 func NewClient(baseURL, username, password string) *Client {
+	return newClient(util.NewHTTPClient(), baseURL, username, password)
+}
+
+func newClient(httpClient *http.Client, baseURL, username, password string) *Client {
 	return &Client{
-		httpClient: httpClientFactory(),
+		httpClient: httpClient,
 		baseURL:    baseURL,
 		host:       hostFromBaseURL(baseURL),
 		username:   username,
@@ -73,13 +100,18 @@ func NewClient(baseURL, username, password string) *Client {
 
 // Exec runs a statement via POST /api/v2/exec.
 func (c *Client) Exec(ctx context.Context, req ExecRequest) (*ExecResponse, error) {
-	body, err := c.postJSON(ctx, execPath, req)
+	if c.mysqlConfig != nil {
+		return c.execMySQL(ctx, req)
+	}
+
+	body, err := c.post(ctx, execPath, req)
 	if err != nil {
 		return nil, err
 	}
+	defer body.Close()
 
 	var result ExecResponse
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("decode exec response: %w", err)
 	}
 
@@ -93,18 +125,26 @@ func (c *Client) Exec(ctx context.Context, req ExecRequest) (*ExecResponse, erro
 	return &result, nil
 }
 
-// QueryRows runs a read query via POST /api/v2/query/rows.
+// QueryRows runs a read query via POST /api/v2/query/tuples and returns all the rows.
 func (c *Client) QueryRows(ctx context.Context, req ExecRequest) (*QueryRowsResponse, error) {
-	body, err := c.postJSON(ctx, queryRowsPath, req)
+	return c.QueryRowsWithLimits(ctx, req, QueryLimits{})
+}
+
+// QueryRowsWithLimits runs a read query and returns the rows up to the limits. The response is
+// decoded while it streams in, so the rows beyond the limits are never held in memory.
+func (c *Client) QueryRowsWithLimits(ctx context.Context, req ExecRequest, limits QueryLimits) (*QueryRowsResponse, error) {
+	if c.mysqlConfig != nil {
+		return c.queryRowsMySQL(ctx, req, limits)
+	}
+
+	body, err := c.post(ctx, queryTuplesPath, req)
 	if err != nil {
 		return nil, err
 	}
+	defer body.Close()
 
-	decoder := json.NewDecoder(bytes.NewReader(body))
-	decoder.UseNumber()
-
-	var result QueryRowsResponse
-	if err := decoder.Decode(&result); err != nil {
+	result, err := decodeTuples(body, limits)
+	if err != nil {
 		return nil, fmt.Errorf("decode query response: %w", err)
 	}
 
@@ -115,10 +155,11 @@ func (c *Client) QueryRows(ctx context.Context, req ExecRequest) (*QueryRowsResp
 		}
 	}
 
-	return &result, nil
+	return result, nil
 }
 
-func (c *Client) postJSON(ctx context.Context, path string, payload ExecRequest) ([]byte, error) {
+// post sends the request and returns the body of a successful response, which the caller closes.
+func (c *Client) post(ctx context.Context, path string, payload ExecRequest) (io.ReadCloser, error) {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("encode request: %w", err)
@@ -140,14 +181,15 @@ func (c *Client) postJSON(ctx context.Context, path string, payload ExecRequest)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
 
 	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
 		return nil, &APIError{
 			StatusCode: resp.StatusCode,
 			Body:       string(respBody),
@@ -155,7 +197,7 @@ func (c *Client) postJSON(ctx context.Context, path string, payload ExecRequest)
 		}
 	}
 
-	return respBody, nil
+	return resp.Body, nil
 }
 
 func hostFromBaseURL(baseURL string) string {
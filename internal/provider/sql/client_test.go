@@ -145,14 +145,14 @@ func TestClientQueryRows_HappyPath(t *testing.T) {
 	t.Parallel()
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		require.Equal(t, "/api/v2/query/rows", r.URL.Path)
+		require.Equal(t, "/api/v2/query/tuples", r.URL.Path)
 
 		body, err := io.ReadAll(r.Body)
 		require.NoError(t, err)
 		require.JSONEq(t, `{"sql":"SELECT id FROM users WHERE id = ?","args":["42"]}`, string(body))
 
 		w.Header().Set("Content-Type", "application/json")
-		_, err = w.Write([]byte(`{"results":[{"rows":[{"id":42,"name":"alice"}]}]}`))
+		_, err = w.Write([]byte(`{"results":[{"columns":[{"name":"id"},{"name":"name"}],"rows":[[42,"alice"]]}]}`))
 		require.NoError(t, err)
 	}))
 	t.Cleanup(server.Close)
@@ -191,6 +191,109 @@ func TestClientQueryRows_InBodyErrorOn200(t *testing.T) {
 	require.Equal(t, "Unknown column 'x'", queryErr.Message)
 }
 
+func TestClientQueryRowsWithLimits(t *testing.T) {
+	t.Parallel()
+
+	// Two result sets of 3 and 2 rows; the rows of the first are about 20 bytes each as encoded.
+	response := `{"results":[` +
+		`{"columns":[{"name":"id","dataType":"BIGINT"},{"name":"name","dataType":"VARCHAR"}],` +
+		`"rows":[[1,"aaaaaaaaaaaaaaa"],[2,"bbbbbbbbbbbbbbb"],[3,"ccccccccccccccc"]]},` +
+		`{"columns":[{"name":"n","dataType":"BIGINT"}],"extra":{"ignored":true},"rows":[[4],[5]]}` +
+		`]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(response))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	client := sql.NewClient(server.URL, "admin", "secret")
+
+	cases := []struct {
+		name      string
+		limits    sql.QueryLimits
+		rows      []int
+		truncated bool
+	}{
+		{name: "unlimited", rows: []int{3, 2}},
+		{name: "within limits", limits: sql.QueryLimits{MaxRows: 5, MaxBytes: 1 << 10}, rows: []int{3, 2}},
+		{name: "max rows in first result set", limits: sql.QueryLimits{MaxRows: 2}, rows: []int{2}, truncated: true},
+		{name: "max rows across result sets", limits: sql.QueryLimits{MaxRows: 4}, rows: []int{3, 1}, truncated: true},
+		{name: "max bytes", limits: sql.QueryLimits{MaxBytes: 50}, rows: []int{2}, truncated: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := client.QueryRowsWithLimits(t.Context(), sql.ExecRequest{SQL: "SELECT id, name FROM t; SELECT n FROM u"}, tc.limits)
+			require.NoError(t, err)
+			require.Equal(t, tc.truncated, resp.Truncated)
+			require.Len(t, resp.Results, len(tc.rows))
+
+			for i, rows := range tc.rows {
+				require.Len(t, resp.Results[i].Rows, rows)
+			}
+
+			require.Equal(t, "aaaaaaaaaaaaaaa", resp.Results[0].Rows[0]["name"])
+			require.Equal(t, "BIGINT", resp.Results[0].Columns[0].DataType)
+		})
+	}
+}
+
+func TestClientQueryRowsWithLimitsDecodesErrorAfterTruncation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"id"}],"rows":[[1],[2],[3]]},{"columns":[{"name":"n"}],"rows":[[4]]}],` +
+			`"error":{"code":1317,"message":"Query execution was interrupted"}}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	client := sql.NewClient(server.URL, "admin", "secret")
+	_, err := client.QueryRowsWithLimits(t.Context(), sql.ExecRequest{SQL: "SELECT id FROM t; SELECT n FROM u"}, sql.QueryLimits{MaxRows: 1})
+
+	var queryErr *sql.QueryError
+	require.ErrorAs(t, err, &queryErr)
+	require.Equal(t, "Query execution was interrupted", queryErr.Message)
+}
+
+func TestClientQueryRowsDecodesNullResults(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"id"}],"rows":null}],"error":null}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	client := sql.NewClient(server.URL, "admin", "secret")
+	resp, err := client.QueryRows(t.Context(), sql.ExecRequest{SQL: "SELECT id FROM t"})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	require.Empty(t, resp.Results[0].Rows)
+	require.False(t, resp.Truncated)
+}
+
+func TestClientQueryRowsRejectsMismatchedTuples(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"id"}],"rows":[[1,2]]}]}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+
+	client := sql.NewClient(server.URL, "admin", "secret")
+	_, err := client.QueryRows(t.Context(), sql.ExecRequest{SQL: "SELECT id FROM t"})
+	require.ErrorContains(t, err, "row has 2 values for 1 columns")
+}
+
 func TestClientHonorsContextCancellation(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,165 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// DefaultUsername is the SQL user of the resources that manage database objects when username is unset.
+const DefaultUsername = "admin"
+
+// ConnectionModel holds the attributes that connect a resource managing database objects to a workspace.
+// Resource models embed it.
+type ConnectionModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+// protocolDescription documents the protocol attribute of the SQL resources and data sources.
+const protocolDescription = "Protocol of the SQL statements. `https` (the default) uses the Data API over HTTPS on port 443. " +
+	"`mysql` uses the MySQL protocol with TLS on port 3306, e.g., in networks that block port 443, " +
+	"and is not subject to the 1 MB request limit of the Data API. JWT authentication with username `*` requires `https`."
+
+// protocolAttribute returns the protocol attribute of the SQL resources.
+func protocolAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: protocolDescription,
+		Validators: []validator.String{
+			stringvalidator.OneOf(ProtocolHTTPS, ProtocolMySQL),
+		},
+	}
+}
+
+// WithConnectionAttributes adds the attributes of ConnectionModel to the attributes of a resource.
+//
+// Changing the endpoint forces replacement because the object lives in another workspace,
+// while the credentials and the protocol can change in place.
+func WithConnectionAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	result := map[string]schema.Attribute{
+		"endpoint": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Workspace SQL endpoint (bare host). Typically `singlestoredb_workspace.<n>.endpoint`. Must not include a port; the port follows from `protocol`. Changing this value forces replacement.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"username": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(DefaultUsername),
+			MarkdownDescription: fmt.Sprintf("SQL user that manages the object. Defaults to `%s`.", DefaultUsername),
+		},
+		"password": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			MarkdownDescription: fmt.Sprintf("Password of the SQL user. Falls back to `%s` when unset.", config.EnvSQLUserPassword),
+		},
+	}
+
+	protocol := protocolAttribute()
+	protocol.MarkdownDescription += " Prefix the import ID with `mysql://` to import the object over the MySQL protocol."
+	result["protocol"] = protocol
+
+	maps.Copy(result, attributes)
+
+	return result
+}
+
+// Client builds a Data API client with the connection attributes.
+func (m ConnectionModel) Client(c Connector) (*Client, *util.SummaryWithDetailError) {
+	password, serr := resolvePassword(m.Password)
+	if serr != nil {
+		return nil, serr
+	}
+
+	username := m.Username.ValueString()
+	if username == "" {
+		username = DefaultUsername
+	}
+
+	return c.buildClient(m.Endpoint.ValueString(), username, password, m.Protocol.ValueString())
+}
+
+// ForState returns the connection attributes to save in the state. Env-sourced passwords are not persisted.
+func (m ConnectionModel) ForState() ConnectionModel {
+	m.Password = passwordForState(m.Password)
+
+	return m
+}
+
+// ImportID joins the endpoint and the names of an object into the ID of a resource, e.g., `<endpoint>/<database>`.
+func ImportID(endpoint string, names ...string) string {
+	return strings.Join(append([]string{endpoint}, names...), "/")
+}
+
+// ImportConnection parses an ID of the form `<endpoint>/<name 1>/.../<name n>` and saves the connection
+// attributes to the state. The credentials are not part of the ID: the default user is saved,
+// and the password is read from the environment until the configuration provides it.
+// An ID prefixed by `mysql://` saves the MySQL protocol, so that the object is read over it.
+func ImportConnection(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, format string, names int) []string {
+	id, isMySQL := strings.CutPrefix(req.ID, ProtocolMySQL+"://")
+
+	parts := strings.SplitN(id, "/", names+1)
+	if len(parts) != names+1 || util.Any(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form %s, got %q.", format, req.ID),
+		)
+
+		return nil
+	}
+
+	if _, err := DataAPIURL(parts[0]); err != nil {
+		serr := InvalidEndpointDiagnostic(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return nil
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(config.IDAttribute), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("endpoint"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), DefaultUsername)...)
+	if isMySQL {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("protocol"), ProtocolMySQL)...)
+	}
+
+	return parts[1:]
+}
+
+// QuoteIdentifier quotes a database, table, or column name with backticks.
+func QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// QuoteString quotes a string literal for statements that do not accept placeholders, e.g., user names.
+func QuoteString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// QueryStringRows runs the query and returns the rows of the first result set with all the values as strings.
+// NULL values are returned as empty strings.
+func QueryStringRows(ctx context.Context, client *Client, req ExecRequest) ([]map[string]string, error) {
+	resp, err := client.QueryRows(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return StringifyRows(firstResultSetRows(resp))
+}
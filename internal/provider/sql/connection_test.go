@@ -0,0 +1,24 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteIdentifier(t *testing.T) {
+	require.Equal(t, "`my_db`", sql.QuoteIdentifier("my_db"))
+	require.Equal(t, "`my``db`", sql.QuoteIdentifier("my`db"))
+}
+
+func TestQuoteString(t *testing.T) {
+	require.Equal(t, "'app_user'", sql.QuoteString("app_user"))
+	require.Equal(t, `'it\'s'`, sql.QuoteString("it's"))
+	require.Equal(t, `'a\\\'b'`, sql.QuoteString(`a\'b`))
+}
+
+func TestImportID(t *testing.T) {
+	require.Equal(t, "workspace.example.com/my_db", sql.ImportID("workspace.example.com", "my_db"))
+	require.Equal(t, "workspace.example.com/my_db/t", sql.ImportID("workspace.example.com", "my_db", "t"))
+}
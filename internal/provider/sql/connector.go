@@ -0,0 +1,31 @@
+package sql
+
+import (
+	"net/http"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// Connector builds the Data API clients of the resources and data sources that run SQL.
+// The zero value uses util.NewHTTPClient.
+type Connector struct {
+	newHTTPClient func() *http.Client
+}
+
+// NewConnector returns the Connector of the provider data passed to Configure.
+func NewConnector(providerData any) Connector {
+	data, ok := providerData.(*util.ProviderData)
+	if !ok {
+		return Connector{}
+	}
+
+	return Connector{newHTTPClient: data.DataAPIHTTPClient}
+}
+
+func (c Connector) httpClient() *http.Client {
+	if c.newHTTPClient == nil {
+		return util.NewHTTPClient()
+	}
+
+	return c.newHTTPClient()
+}
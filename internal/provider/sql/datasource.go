@@ -7,29 +7,47 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 )
 
 const DataSourceName = "sql_query"
 
-var _ datasource.DataSource = &sqlQueryDataSource{}
+var (
+	_ datasource.DataSourceWithConfigure      = &sqlQueryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &sqlQueryDataSource{}
+)
 
 type sqlQueryDataSourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Endpoint types.String `tfsdk:"endpoint"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Database types.String `tfsdk:"database"`
-	Query    types.String `tfsdk:"query"`
-	Args     types.List   `tfsdk:"args"`
-	Rows     types.List   `tfsdk:"rows"`
+	ID          types.String  `tfsdk:"id"`
+	Endpoint    types.String  `tfsdk:"endpoint"`
+	Username    types.String  `tfsdk:"username"`
+	Password    types.String  `tfsdk:"password"`
+	Protocol    types.String  `tfsdk:"protocol"`
+	AutoResume  types.Bool    `tfsdk:"auto_resume"`
+	WorkspaceID types.String  `tfsdk:"workspace_id"`
+	Database    types.String  `tfsdk:"database"`
+	Query       types.String  `tfsdk:"query"`
+	Args        types.List    `tfsdk:"args"`
+	MaxRows     types.Int64   `tfsdk:"max_rows"`
+	MaxBytes    types.Int64   `tfsdk:"max_bytes"`
+	Rows        types.List    `tfsdk:"rows"`
+	TypedRows   types.Dynamic `tfsdk:"typed_rows"`
+	Columns     types.List    `tfsdk:"columns"`
+	ResultSets  types.Dynamic `tfsdk:"result_sets"`
 }
 
-type sqlQueryDataSource struct{}
+type sqlQueryDataSource struct {
+	management.ClientWithResponsesInterface
+	Connector
+}
 
 func NewDataSourceQuery() datasource.DataSource {
 	return &sqlQueryDataSource{}
@@ -41,7 +59,7 @@ func (d *sqlQueryDataSource) Metadata(_ context.Context, req datasource.Metadata
 
 func (d *sqlQueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Run a read-only SQL query against a SingleStore Helios workspace via the Data API. " +
+		MarkdownDescription: "Run a read-only SQL query against a SingleStore Helios workspace via the Data API or the MySQL protocol. " +
 			"Re-runs on every plan and apply; heavy queries add latency and load to the workspace. " +
 			"Use singlestoredb_sql_execute for DDL and DML.",
 		Attributes: map[string]schema.Attribute{
@@ -51,7 +69,7 @@ func (d *sqlQueryDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			},
 			"endpoint": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Workspace SQL endpoint (bare host). Typically `singlestoredb_workspace.<n>.endpoint`. Must not include a port; the Data API uses HTTPS on port 443.",
+				MarkdownDescription: "Workspace SQL endpoint (bare host). Typically `singlestoredb_workspace.<n>.endpoint`. Must not include a port; the port follows from `protocol`.",
 			},
 			"username": schema.StringAttribute{
 				Required:            true,
@@ -62,28 +80,97 @@ func (d *sqlQueryDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Sensitive:           true,
 				MarkdownDescription: fmt.Sprintf("SQL user password or JWT when `username` is `*`. Falls back to `%s` when unset.", config.EnvSQLUserPassword),
 			},
+			"protocol": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: protocolDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ProtocolHTTPS, ProtocolMySQL),
+				},
+			},
+			"auto_resume": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: autoResumeDescription,
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: workspaceIDDescription,
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
 			"database": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Context database for the query.",
 			},
 			"query": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Read-only SQL (typically SELECT). Only the first result set is returned; all cell values are strings.",
+				Required: true,
+				MarkdownDescription: "Read-only SQL (typically SELECT). `rows`, `typed_rows`, and `columns` are from the first result set; " +
+					"`result_sets` has every result set of a multi-statement query. " +
+					"The query runs during plan whenever its inputs are known, so invalid SQL fails the plan without a `validate_on_plan` attribute like the one of `singlestoredb_sql_execute`. " +
+					"Reads that Terraform defers to apply, e.g., because of unknown inputs or `depends_on` with pending changes, cannot be validated during plan either, since data sources have no plan step.",
 			},
 			"args": schema.ListAttribute{
 				Optional:            true,
 				ElementType:         types.StringType,
 				MarkdownDescription: "Positional arguments for `?` placeholders in `query`.",
 			},
+			"max_rows": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: maxRowsDescription("query"),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_bytes": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: maxBytesDescription("query"),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"rows": schema.ListAttribute{
 				Computed:            true,
 				ElementType:         QueryResultsElementType,
-				MarkdownDescription: "Rows from the first result set. All values are strings.",
+				MarkdownDescription: "Rows from the first result set. All values are strings, and `NULL` is an empty string.",
+			},
+			"typed_rows": schema.DynamicAttribute{
+				Computed: true,
+				MarkdownDescription: "Rows from the first result set as a list of objects with an attribute per column. " +
+					"Numeric columns are numbers without loss of precision, boolean values are bools, `NULL` is `null`, and all other values are strings.",
+			},
+			"columns": schema.ListAttribute{
+				Computed:    true,
+				ElementType: ColumnsElementType,
+				MarkdownDescription: "Columns of the first result set in select-list order, with their `name`, SQL `type`, and whether they are `nullable`. " +
+					"`type` is null when the Data API does not report column metadata.",
+			},
+			"result_sets": schema.DynamicAttribute{
+				Computed: true,
+				MarkdownDescription: "Every result set of `query` in order, e.g., of several `SELECT` statements separated by semicolons. " +
+					"Each result set is an object with `rows`, `typed_rows`, and `columns` like the attributes of the same names.",
 			},
 		},
 	}
 }
 
+func (d *sqlQueryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var conf sqlQueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(ValidateAutoResume(conf.AutoResume, conf.WorkspaceID)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sqlQueryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	d.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	d.Connector = NewConnector(req.ProviderData)
+}
+
 func (d *sqlQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var model sqlQueryDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
@@ -98,24 +185,32 @@ func (d *sqlQueryDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	client, serr := buildClient(model.Endpoint.ValueString(), model.Username.ValueString(), password)
+	client, serr := d.buildClient(model.Endpoint.ValueString(), model.Username.ValueString(), password, model.Protocol.ValueString())
 	if serr != nil {
 		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
 
 		return
 	}
 
+	autoResume := AutoResume{Client: d.ClientWithResponsesInterface, Enabled: model.AutoResume, WorkspaceID: model.WorkspaceID}
+	if serr := autoResume.Resume(ctx, client, model.Endpoint.ValueString()); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
 	args, diags := ListStrings(ctx, model.Args)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	queryResp, err := client.QueryRows(ctx, ExecRequest{
+	limits := NewQueryLimits(model.MaxRows, model.MaxBytes)
+	queryResp, err := client.QueryRowsWithLimits(ctx, ExecRequest{
 		SQL:      model.Query.ValueString(),
 		Args:     StringArgsToAny(args),
 		Database: model.Database.ValueString(),
-	})
+	}, limits)
 	if err != nil {
 		serr := DiagnosticFromError(err)
 		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
@@ -123,20 +218,21 @@ func (d *sqlQueryDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	stringified, err := StringifyRows(firstResultSetRows(queryResp))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to parse query results", err.Error())
-
-		return
+	if queryResp.Truncated {
+		serr := TruncatedDiagnostic(limits, "max_rows", "max_bytes")
+		resp.Diagnostics.AddWarning(serr.Summary, serr.Detail)
 	}
 
-	rows, listDiags := RowsToTFList(ctx, stringified)
-	resp.Diagnostics.Append(listDiags...)
+	result, resultDiags := newQueryResult(ctx, queryResp)
+	resp.Diagnostics.Append(resultDiags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	model.Rows = rows
+	model.Rows = result.Rows
+	model.TypedRows = result.TypedRows
+	model.Columns = result.Columns
+	model.ResultSets = result.ResultSets
 	model.ID = types.StringValue(queryDataSourceID(client.baseURL, model.Query.ValueString(), args))
 	model.Password = passwordForState(model.Password)
 
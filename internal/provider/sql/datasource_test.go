@@ -29,12 +29,12 @@ data "singlestoredb_sql_query" "this" {
   query    = "SELECT id, email FROM users WHERE created_at > ?"
   args     = %s
 }
-`, testWorkspaceEndpoint, args)
+`, testutil.TestWorkspaceEndpoint, args)
 }
 
 func TestSQLQueryReadReturnsRows(t *testing.T) {
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		require.Equal(t, "/api/v2/query/rows", r.URL.Path)
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v2/query/tuples", r.URL.Path)
 		require.Equal(t, http.MethodPost, r.Method)
 
 		user, pass, ok := r.BasicAuth()
@@ -47,12 +47,13 @@ func TestSQLQueryReadReturnsRows(t *testing.T) {
 		require.JSONEq(t, `{"sql":"SELECT id, email FROM users WHERE created_at > ?","args":["2025-01-01"],"database":"my_app_db"}`, string(body))
 
 		w.Header().Set("Content-Type", "application/json")
-		_, err = w.Write([]byte(`{"results":[{"rows":[{"id":1,"email":"alice@example.com"},{"id":2,"email":"bob@example.com"}]}]}`))
+		_, err = w.Write([]byte(`{"results":[{"columns":[{"name":"id"},{"name":"email"}],"rows":[[1,"alice@example.com"],[2,"bob@example.com"]]}]}`))
 		require.NoError(t, err)
 	}))
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -70,15 +71,113 @@ func TestSQLQueryReadReturnsRows(t *testing.T) {
 	})
 }
 
+func TestSQLQueryReadReturnsTypedRows(t *testing.T) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results":[{
+			"columns":[{"name":"id","dataType":"BIGINT","nullable":false},{"name":"email","dataType":"VARCHAR","nullable":true}],
+			"rows":[[1,"alice@example.com"],[2,null]]
+		}]}`))
+		require.NoError(t, err)
+	}))
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: sqlQueryConfig(`["2025-01-01"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "rows.1.email", ""),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "typed_rows.#", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "typed_rows.0.id", "1"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "typed_rows.0.email", "alice@example.com"),
+					resource.TestCheckNoResourceAttr("data.singlestoredb_sql_query.this", "typed_rows.1.email"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "columns.#", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "columns.0.name", "id"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "columns.0.type", "BIGINT"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "columns.1.nullable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestSQLQueryReadReturnsAllResultSets(t *testing.T) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results":[
+			{"columns":[{"name":"id","dataType":"BIGINT","nullable":false}],"rows":[[1]]},
+			{"columns":[{"name":"users","dataType":"BIGINT","nullable":false},{"name":"admins","dataType":"BIGINT","nullable":false}],"rows":[[10,2]]}
+		]}`))
+		require.NoError(t, err)
+	}))
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: sqlQueryConfig(`["2025-01-01"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "rows.#", "1"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "result_sets.#", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "result_sets.0.rows.0.id", "1"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "result_sets.1.typed_rows.0.users", "10"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "result_sets.1.columns.1.name", "admins"),
+				),
+			},
+		},
+	})
+}
+
+func TestSQLQueryReadTruncatesAtMaxRows(t *testing.T) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"id"},{"name":"email"}],"rows":[[1,"alice@example.com"],[2,"bob@example.com"],[3,"carol@example.com"]]}]}`))
+		require.NoError(t, err)
+	}))
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+data "singlestoredb_sql_query" "this" {
+  endpoint = %q
+  username = "admin"
+  password = "secret"
+  query    = "SELECT id, email FROM users"
+  max_rows = 2
+}
+`, testutil.TestWorkspaceEndpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "rows.1.email", "bob@example.com"),
+					resource.TestCheckResourceAttr("data.singlestoredb_sql_query.this", "typed_rows.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestSQLQueryHardErrorOnQueryFailure(t *testing.T) {
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		_, err := w.Write([]byte(`{"error":{"code":1064,"message":"You have an error in your SQL syntax"}}`))
 		require.NoError(t, err)
 	}))
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -101,7 +200,7 @@ data "singlestoredb_sql_query" "this" {
   username = "admin"
   query    = "SELECT 1"
 }
-`, testWorkspaceEndpoint)
+`, testutil.TestWorkspaceEndpoint)
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
 		APIKey: testutil.UnusedAPIKey,
@@ -116,16 +215,17 @@ data "singlestoredb_sql_query" "this" {
 }
 
 func TestSQLQueryIDChangesWhenArgsChange(t *testing.T) {
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		_, err := w.Write([]byte(`{"results":[{"rows":[{"id":1,"email":"alice@example.com"}]}]}`))
+		_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"id"},{"name":"email"}],"rows":[[1,"alice@example.com"]]}]}`))
 		require.NoError(t, err)
 	}))
 
 	var firstID string
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -155,7 +255,7 @@ func TestSQLQueryIDChangesWhenArgsChange(t *testing.T) {
 }
 
 func TestSQLQueryDataSourceIntegration(t *testing.T) {
-	adminPassword := testAdminPassword
+	adminPassword := testutil.TestAdminPassword
 	isDataAPIReady := testutil.IsDataAPIReady(adminPassword)
 
 	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
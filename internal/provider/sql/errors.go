@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 )
 
@@ -29,7 +30,7 @@ func (RequestTooLargeError) Error() string {
 	return "sql statement exceeds the data api 1 mb request limit"
 }
 
-// QueryError is returned when query/rows responds with HTTP 200 but an in-body error field.
+// QueryError is returned when query/tuples responds with HTTP 200 but an in-body error field.
 type QueryError struct {
 	Message string
 	Host    string
@@ -62,7 +63,7 @@ func DiagnosticFromError(err error) *util.SummaryWithDetailError {
 	if errors.As(err, &tooLarge) {
 		return &util.SummaryWithDetailError{
 			Summary: "SQL statement exceeds the Data API 1 MB request limit",
-			Detail:  "Shorten the statement or split across multiple singlestoredb_sql_execute resources.",
+			Detail:  "Shorten the statement, split across multiple singlestoredb_sql_execute resources, or set protocol to \"mysql\", which does not have this limit.",
 		}
 	}
 
@@ -87,6 +88,13 @@ func DiagnosticFromError(err error) *util.SummaryWithDetailError {
 		return diagnosticFromAPIError(apiErr)
 	}
 
+	if isMySQLAccessDenied(err) {
+		return &util.SummaryWithDetailError{
+			Summary: "Invalid SingleStore SQL credentials",
+			Detail:  "Check username and password. " + err.Error(),
+		}
+	}
+
 	if IsUnreachable(err) {
 		host := unreachableHost(err)
 
@@ -166,6 +174,35 @@ func IsUnreachable(err error) bool {
 		strings.Contains(msg, "i/o timeout")
 }
 
+// WarnUnreachableOnRead adds the diagnostic of an error that failed reading a SQL object.
+// A suspended or deleted workspace should not fail the plans of the configurations that manage it,
+// so an unreachable workspace is a warning and the caller keeps the state as is.
+func WarnUnreachableOnRead(diags *diag.Diagnostics, err error) {
+	serr := DiagnosticFromError(err)
+	if IsUnreachable(err) {
+		diags.AddWarning(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	diags.AddError(serr.Summary, serr.Detail)
+}
+
+// WarnUnreachableOnDelete adds the diagnostic of an error that failed deleting a SQL object.
+// As with singlestoredb_sql_execute, a deleted or suspended workspace should not wedge destroy,
+// so an unreachable workspace is a warning and the resource is removed from the state anyway.
+// IsUnreachable also matches transient network failures, which is the accepted trade-off.
+func WarnUnreachableOnDelete(diags *diag.Diagnostics, err error) {
+	serr := DiagnosticFromError(err)
+	if IsUnreachable(err) {
+		diags.AddWarning(serr.Summary, "The resource is removed from the state without changing the workspace. "+serr.Detail)
+
+		return
+	}
+
+	diags.AddError(serr.Summary, serr.Detail)
+}
+
 // isUnreachableNetworkError reports whether err is a typed error that indicates
 // the workspace could not be reached.
 func isUnreachableNetworkError(err error) bool {
@@ -7,6 +7,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
 	"github.com/stretchr/testify/require"
 )
@@ -109,6 +110,31 @@ func TestIsUnreachable(t *testing.T) {
 	require.False(t, sql.IsUnreachable(fmt.Errorf("exec: %w", context.Canceled)))
 }
 
+func TestWarnUnreachable(t *testing.T) {
+	t.Parallel()
+
+	unreachable := &sql.APIError{StatusCode: 503, Host: "svc.example.com"}
+	failed := &sql.APIError{StatusCode: 401, Host: "svc.example.com"}
+
+	var diags diag.Diagnostics
+	sql.WarnUnreachableOnRead(&diags, unreachable)
+	require.False(t, diags.HasError())
+	require.Equal(t, 1, diags.WarningsCount())
+
+	diags = nil
+	sql.WarnUnreachableOnDelete(&diags, unreachable)
+	require.False(t, diags.HasError())
+	require.Contains(t, diags[0].Detail(), "removed from the state")
+
+	diags = nil
+	sql.WarnUnreachableOnRead(&diags, failed)
+	require.True(t, diags.HasError())
+
+	diags = nil
+	sql.WarnUnreachableOnDelete(&diags, failed)
+	require.True(t, diags.HasError())
+}
+
 func TestInvalidEndpointDiagnostic(t *testing.T) {
 	t.Parallel()
 
@@ -1,8 +1,13 @@
 package sql
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 )
@@ -27,12 +32,49 @@ func FirstResultSetRowsForTest(resp *QueryRowsResponse) []map[string]any {
 	return firstResultSetRows(resp)
 }
 
-// SetHTTPClientFactoryForTest overrides the HTTP client used by NewClient. Returns a restore func.
-func SetHTTPClientFactoryForTest(factory func() *http.Client) func() {
-	prev := httpClientFactory
-	httpClientFactory = factory
+// WaitForDataAPIForTest exposes waitForDataAPI for external tests.
+func WaitForDataAPIForTest(ctx context.Context, client *Client, endpoint string, timeout time.Duration) *util.SummaryWithDetailError {
+	return waitForDataAPI(ctx, client, endpoint, timeout)
+}
+
+// ResultColumnsForTest exposes resultColumns for external tests.
+func ResultColumnsForTest(set QueryResultSet) []QueryColumn {
+	return resultColumns(set)
+}
+
+// ResultSetsForTest exposes the result_sets value of newQueryResult for external tests.
+func ResultSetsForTest(ctx context.Context, resp *QueryRowsResponse) (types.Dynamic, diag.Diagnostics) {
+	result, diags := newQueryResult(ctx, resp)
+
+	return result.ResultSets, diags
+}
+
+// MySQLConfigForTest exposes the MySQL protocol configuration of a client for external tests.
+func MySQLConfigForTest(client *Client) *mysql.Config {
+	return client.mysqlConfig
+}
+
+// MySQLValueForTest exposes mysqlValue for external tests.
+func MySQLValueForTest(value any, dataType string) any {
+	return mysqlValue(value, dataType)
+}
+
+// MySQLErrorForTest exposes the conversion of MySQL query errors for external tests.
+func MySQLErrorForTest(client *Client, err error) error {
+	return client.mysqlError(err, func(message string) error { return &QueryError{Message: message, Host: client.host} })
+}
+
+// ExplainedStatementsForTest exposes explainedStatements for external tests.
+func ExplainedStatementsForTest(statements []string) []string {
+	return explainedStatements(statements)
+}
+
+// ExplainStatementsForTest exposes explainStatements for external tests.
+func ExplainStatementsForTest(ctx context.Context, client *Client, attribute string, statements []string) diag.Diagnostics {
+	return explainStatements(ctx, client, "", path.Root(attribute), statements, nil)
+}
 
-	return func() {
-		httpClientFactory = prev
-	}
+// NewClientForTest exposes newClient for external tests.
+func NewClientForTest(httpClient *http.Client, baseURL, username, password string) *Client {
+	return newClient(httpClient, baseURL, username, password)
 }
@@ -0,0 +1,54 @@
+package sql
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	// DefaultMaxRows is the number of query rows kept in state when no limit is configured.
+	DefaultMaxRows = 10000
+	// DefaultMaxBytes is the size of the query rows kept in state when no limit is configured.
+	DefaultMaxBytes = 4 << 20
+)
+
+// NewQueryLimits returns the limits of the max rows and max bytes attributes, with the defaults for unset ones.
+func NewQueryLimits(maxRows, maxBytes types.Int64) QueryLimits {
+	limits := QueryLimits{MaxRows: DefaultMaxRows, MaxBytes: DefaultMaxBytes}
+	if !maxRows.IsNull() && !maxRows.IsUnknown() {
+		limits.MaxRows = int(maxRows.ValueInt64())
+	}
+
+	if !maxBytes.IsNull() && !maxBytes.IsUnknown() {
+		limits.MaxBytes = maxBytes.ValueInt64()
+	}
+
+	return limits
+}
+
+// TruncatedDiagnostic is the warning for query results cut off at the limits of the named attributes.
+func TruncatedDiagnostic(limits QueryLimits, maxRowsAttribute, maxBytesAttribute string) *util.SummaryWithDetailError {
+	return &util.SummaryWithDetailError{
+		Summary: "Query results truncated",
+		Detail: fmt.Sprintf("The query returned more than %d rows or %d bytes, so only the rows within these limits are kept. "+
+			"Add a LIMIT clause or select fewer columns, or raise %s or %s if all rows are needed.",
+			limits.MaxRows, limits.MaxBytes, maxRowsAttribute, maxBytesAttribute,
+		),
+	}
+}
+
+func maxRowsDescription(queryAttribute string) string {
+	return fmt.Sprintf("Maximum number of rows of `%s` kept, across all result sets. Defaults to %d. "+
+		"Further rows are dropped with a warning, which keeps an unbounded query from bloating plan memory and the state file.",
+		queryAttribute, DefaultMaxRows,
+	)
+}
+
+func maxBytesDescription(queryAttribute string) string {
+	return fmt.Sprintf("Maximum size in bytes of the rows of `%s` kept, as encoded in the response. Defaults to %d (4 MiB). "+
+		"Further rows are dropped with a warning.",
+		queryAttribute, DefaultMaxBytes,
+	)
+}
@@ -0,0 +1,276 @@
+package sql
+
+import (
+	"context"
+	"crypto/tls"
+	dbsql "database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	// ProtocolHTTPS sends statements to the Data API over HTTPS on port 443. It is the default.
+	ProtocolHTTPS = "https"
+	// ProtocolMySQL sends statements over the MySQL wire protocol with TLS on port 3306.
+	ProtocolMySQL = "mysql"
+
+	mysqlPort        = "3306"
+	mysqlDialTimeout = 30 * time.Second
+	// mysqlAccessDenied is the error number of failed logins (ER_ACCESS_DENIED_ERROR).
+	mysqlAccessDenied = 1045
+)
+
+var (
+	// mysqlPools keeps a connection pool per server, user, and database for the lifetime of
+	// the provider, since clients are created for every operation. The key leaves out the password,
+	// so that it is not kept in the map, and a pool is replaced when the password changes.
+	mysqlPools   = map[string]mysqlPool{}
+	mysqlPoolsMu sync.Mutex
+)
+
+type mysqlPool struct {
+	db       *dbsql.DB
+	password string
+}
+
+// NewMySQLClient creates a client that uses the MySQL protocol with TLS instead of the Data API.
+// Requests behave like the Data API ones: `?` placeholders take the arguments, the database is
+// the context database, and queries may return several result sets.
+func NewMySQLClient(host, username, password string) *Client {
+	cfg := mysql.NewConfig()
+	cfg.User = username
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(host, mysqlPort)
+	cfg.TLS = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+	// JWTs are sent as cleartext passwords, which TLS protects.
+	cfg.AllowCleartextPasswords = true
+	// Interpolating the arguments avoids server-side prepared statements, which DDL does not support.
+	cfg.InterpolateParams = true
+	cfg.MultiStatements = true
+	cfg.Timeout = mysqlDialTimeout
+
+	return &Client{
+		baseURL:     ProtocolMySQL + "://" + cfg.Addr,
+		host:        host,
+		username:    username,
+		password:    password,
+		mysqlConfig: cfg,
+	}
+}
+
+// mysqlConn opens a connection to the database for a single request. The statements of a request
+// may change the session, e.g., with USE, SET, or transactions, so the pools keep no idle connections
+// and every connection is closed when the request closes it.
+func (c *Client) mysqlConn(ctx context.Context, database string) (*dbsql.Conn, error) {
+	cfg := c.mysqlConfig.Clone()
+	cfg.DBName = database
+	key := fmt.Sprintf("%s@%s/%s", cfg.User, cfg.Addr, cfg.DBName)
+
+	mysqlPoolsMu.Lock()
+	pool, ok := mysqlPools[key]
+	if !ok || pool.password != cfg.Passwd {
+		if ok {
+			_ = pool.db.Close()
+		}
+
+		connector, err := mysql.NewConnector(cfg)
+		if err != nil {
+			mysqlPoolsMu.Unlock()
+
+			return nil, err
+		}
+
+		pool = mysqlPool{db: dbsql.OpenDB(connector), password: cfg.Passwd}
+		pool.db.SetMaxIdleConns(0)
+		mysqlPools[key] = pool
+	}
+	mysqlPoolsMu.Unlock()
+
+	return pool.db.Conn(ctx)
+}
+
+// CloseMySQLPools closes the connection pools of the MySQL protocol. Call it when the provider stops.
+func CloseMySQLPools() {
+	mysqlPoolsMu.Lock()
+	defer mysqlPoolsMu.Unlock()
+
+	for key, pool := range mysqlPools {
+		_ = pool.db.Close()
+		delete(mysqlPools, key)
+	}
+}
+
+func (c *Client) execMySQL(ctx context.Context, req ExecRequest) (*ExecResponse, error) {
+	conn, err := c.mysqlConn(ctx, req.Database)
+	if err != nil {
+		return nil, c.mysqlError(err, func(message string) error { return &ExecError{Message: message, Host: c.host} })
+	}
+	defer conn.Close()
+
+	result, err := conn.ExecContext(ctx, req.SQL, req.Args...)
+	if err != nil {
+		return nil, c.mysqlError(err, func(message string) error { return &ExecError{Message: message, Host: c.host} })
+	}
+
+	// The driver reports both values from the OK packet, so neither call fails.
+	lastInsertID, _ := result.LastInsertId()
+	rowsAffected, _ := result.RowsAffected()
+
+	return &ExecResponse{LastInsertID: lastInsertID, RowsAffected: rowsAffected}, nil
+}
+
+func (c *Client) queryRowsMySQL(ctx context.Context, req ExecRequest, limits QueryLimits) (*QueryRowsResponse, error) {
+	queryError := func(message string) error { return &QueryError{Message: message, Host: c.host} }
+
+	conn, err := c.mysqlConn(ctx, req.Database)
+	if err != nil {
+		return nil, c.mysqlError(err, queryError)
+	}
+	defer conn.Close()
+
+	rows, err := conn.QueryContext(ctx, req.SQL, req.Args...)
+	if err != nil {
+		return nil, c.mysqlError(err, queryError)
+	}
+	defer rows.Close()
+
+	result := &QueryRowsResponse{}
+	budget := &rowBudget{limits: limits}
+	for {
+		set, truncated, err := scanResultSet(rows, budget)
+		if err != nil {
+			return nil, c.mysqlError(err, queryError)
+		}
+
+		// Statements without a result set, e.g., SET, are skipped like in the Data API.
+		if len(set.Columns) > 0 {
+			result.Results = append(result.Results, set)
+		}
+
+		if truncated {
+			result.Truncated = true
+
+			return result, nil
+		}
+
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, c.mysqlError(err, queryError)
+	}
+
+	return result, nil
+}
+
+// mysqlError converts a server error to the error the Data API returns for it. Failed logins
+// and network errors are kept, so that they are reported like invalid credentials and an
+// unreachable workspace.
+func (c *Client) mysqlError(err error, statementError func(message string) error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number != mysqlAccessDenied {
+		return statementError(mysqlErr.Message)
+	}
+
+	return err
+}
+
+// scanResultSet reads the current result set of rows with the values in the form the Data API returns them.
+// It reports whether a row was dropped because of the limits.
+func scanResultSet(rows *dbsql.Rows, budget *rowBudget) (QueryResultSet, bool, error) {
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return QueryResultSet{}, false, err
+	}
+
+	set := QueryResultSet{Columns: make([]QueryColumn, len(columnTypes)), Rows: []map[string]any{}}
+	for i, columnType := range columnTypes {
+		nullable, _ := columnType.Nullable()
+		set.Columns[i] = QueryColumn{
+			Name:     columnType.Name(),
+			DataType: columnType.DatabaseTypeName(),
+			Nullable: nullable,
+		}
+	}
+
+	values := make([]any, len(columnTypes))
+	pointers := make([]any, len(columnTypes))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return QueryResultSet{}, false, err
+		}
+
+		row := make(map[string]any, len(values))
+		var size int64
+		for i, value := range values {
+			row[set.Columns[i].Name] = mysqlValue(value, set.Columns[i].DataType)
+			size += mysqlValueSize(value)
+		}
+
+		if !budget.take(size) {
+			return set, true, nil
+		}
+
+		set.Rows = append(set.Rows, row)
+	}
+
+	return set, false, rows.Err()
+}
+
+// mysqlValueSize approximates the size of a value in a Data API response for QueryLimits.MaxBytes.
+func mysqlValueSize(value any) int64 {
+	switch v := value.(type) {
+	case nil:
+		return int64(len("null"))
+	case []byte:
+		return int64(len(v))
+	case string:
+		return int64(len(v))
+	default:
+		return int64(len(fmt.Sprint(v)))
+	}
+}
+
+// mysqlValue converts a value scanned from the MySQL protocol to the JSON value of the Data API:
+// numbers are json.Number like with UseNumber, and text is a string.
+func mysqlValue(value any, dataType string) any {
+	switch v := value.(type) {
+	case []byte:
+		if isNumericDataType(dataType) {
+			return json.Number(v)
+		}
+
+		return string(v)
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10))
+	case uint64:
+		return json.Number(strconv.FormatUint(v, 10))
+	case float32:
+		return json.Number(strconv.FormatFloat(float64(v), 'g', -1, 32))
+	case float64:
+		return json.Number(strconv.FormatFloat(v, 'g', -1, 64))
+	default:
+		return v
+	}
+}
+
+// isMySQLAccessDenied reports whether err is a failed login over the MySQL protocol.
+func isMySQLAccessDenied(err error) bool {
+	var mysqlErr *mysql.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlAccessDenied
+}
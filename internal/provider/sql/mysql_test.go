@@ -0,0 +1,82 @@
+package sql_test
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMySQLClient(t *testing.T) {
+	t.Parallel()
+
+	cfg := sql.MySQLConfigForTest(sql.NewMySQLClient("svc.example.com", "admin", "secret"))
+	require.Equal(t, "tcp", cfg.Net)
+	require.Equal(t, "svc.example.com:3306", cfg.Addr)
+	require.Equal(t, "admin", cfg.User)
+	require.Equal(t, "secret", cfg.Passwd)
+	require.NotNil(t, cfg.TLS, "the MySQL protocol always uses TLS")
+	require.Equal(t, "svc.example.com", cfg.TLS.ServerName)
+	require.False(t, cfg.TLS.InsecureSkipVerify)
+	require.GreaterOrEqual(t, cfg.TLS.MinVersion, uint16(tls.VersionTLS12))
+	require.True(t, cfg.InterpolateParams, "arguments must not need server-side prepared statements")
+	require.True(t, cfg.MultiStatements)
+}
+
+func TestConnectionModelClientProtocol(t *testing.T) {
+	t.Parallel()
+
+	model := sql.ConnectionModel{
+		Endpoint: types.StringValue("svc.example.com"),
+		Username: types.StringValue("admin"),
+		Password: types.StringValue("secret"),
+		Protocol: types.StringValue(sql.ProtocolMySQL),
+	}
+
+	client, serr := model.Client(sql.Connector{})
+	require.Nil(t, serr)
+	require.NotNil(t, sql.MySQLConfigForTest(client))
+
+	model.Protocol = types.StringNull()
+	client, serr = model.Client(sql.Connector{})
+	require.Nil(t, serr)
+	require.Nil(t, sql.MySQLConfigForTest(client), "the Data API is the default")
+
+	model.Protocol = types.StringValue(sql.ProtocolMySQL)
+	model.Username = types.StringValue("*")
+	_, serr = model.Client(sql.Connector{})
+	require.NotNil(t, serr)
+	require.Equal(t, "JWT authentication requires the Data API", serr.Summary)
+}
+
+func TestMySQLValue(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, sql.MySQLValueForTest(nil, "VARCHAR"))
+	require.Equal(t, "alice", sql.MySQLValueForTest([]byte("alice"), "VARCHAR"))
+	require.Equal(t, json.Number("18446744073709551615"), sql.MySQLValueForTest([]byte("18446744073709551615"), "UNSIGNED BIGINT"))
+	require.Equal(t, json.Number("12.50"), sql.MySQLValueForTest([]byte("12.50"), "DECIMAL"))
+	require.Equal(t, json.Number("42"), sql.MySQLValueForTest(int64(42), "BIGINT"))
+	require.Equal(t, json.Number("0.5"), sql.MySQLValueForTest(0.5, "DOUBLE"))
+	require.Equal(t, `{"a":1}`, sql.MySQLValueForTest([]byte(`{"a":1}`), "JSON"))
+}
+
+func TestMySQLErrors(t *testing.T) {
+	t.Parallel()
+
+	client := sql.NewMySQLClient("svc.example.com", "admin", "secret")
+
+	err := sql.MySQLErrorForTest(client, &mysql.MySQLError{Number: 1146, Message: "Table 'app.t' doesn't exist"})
+	diag := sql.DiagnosticFromError(err)
+	require.Equal(t, "SQL query failed", diag.Summary)
+	require.Equal(t, "Table 'app.t' doesn't exist", diag.Detail)
+
+	err = sql.MySQLErrorForTest(client, &mysql.MySQLError{Number: 1045, Message: "Access denied for user 'admin'"})
+	diag = sql.DiagnosticFromError(err)
+	require.Equal(t, "Invalid SingleStore SQL credentials", diag.Summary)
+	require.Contains(t, diag.Detail, "Access denied")
+}
@@ -0,0 +1,192 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const ReadyResourceName = "data_api_ready"
+
+// readyQuery is the trivial query that succeeds once the Data API serves the workspace.
+const readyQuery = "SELECT 1"
+
+var (
+	_ resource.Resource              = &dataAPIReadyResource{}
+	_ resource.ResourceWithConfigure = &dataAPIReadyResource{}
+)
+
+type dataAPIReadyResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Endpoint types.String `tfsdk:"endpoint"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+type dataAPIReadyResource struct {
+	Connector
+}
+
+func NewReadyResource() resource.Resource {
+	return &dataAPIReadyResource{}
+}
+
+func (r *dataAPIReadyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ReadyResourceName)
+}
+
+func (r *dataAPIReadyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Wait until the Data API of a SingleStore Helios workspace accepts queries. " +
+			"The Data API may lag behind the workspace becoming active, so SQL resources should depend on this resource " +
+			"rather than on the workspace directly. The wait runs on create only; the resource does not manage any object.",
+		Attributes: map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Random UUID assigned at create time.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"endpoint": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Workspace SQL endpoint (bare host). Typically `singlestoredb_workspace.<n>.endpoint`. Changing this value forces replacement, which waits for the new endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "SQL user name, typically `admin`. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: fmt.Sprintf("SQL user password, typically `singlestoredb_workspace_group.<n>.admin_password`. Falls back to `%s` when unset.", config.EnvSQLUserPassword),
+			},
+			"protocol": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Protocol to wait for. `https` (the default) waits for the Data API on port 443, " +
+					"and `mysql` waits for the MySQL protocol with TLS on port 3306. Changing this value forces replacement, which waits for the new protocol.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(ProtocolHTTPS, ProtocolMySQL),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *dataAPIReadyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataAPIReadyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, serr := resolvePassword(plan.Password)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	client, serr := r.buildClient(plan.Endpoint.ValueString(), plan.Username.ValueString(), password, plan.Protocol.ValueString())
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if serr := waitForDataAPI(ctx, client, plan.Endpoint.ValueString(), config.DataAPIReadyTimeout); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	plan.Password = passwordForState(plan.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is. Checking the Data API on every refresh would fail plans
+// of suspended workspaces, while the readiness only matters for the first apply.
+func (r *dataAPIReadyResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+func (r *dataAPIReadyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataAPIReadyResourceModel
+	var state dataAPIReadyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Password = passwordForState(plan.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dataAPIReadyResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *dataAPIReadyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = NewConnector(req.ProviderData)
+}
+
+// waitForDataAPI polls the Data API with a trivial query until it succeeds consistently.
+//
+// All the errors are retried, including invalid credentials, because the Data API
+// may reject connections with any status while the workspace is starting.
+func waitForDataAPI(ctx context.Context, client *Client, endpoint string, timeout time.Duration) *util.SummaryWithDetailError {
+	_, err := util.Poller[struct{}]{
+		Description:       fmt.Sprintf("the Data API of the workspace %s", endpoint),
+		Timeout:           timeout,
+		ConsistencyWindow: config.DataAPIReadyConsistencyThreshold,
+		Get: func(ctx context.Context) (struct{}, error) {
+			_, err := client.QueryRows(ctx, ExecRequest{SQL: readyQuery})
+
+			return struct{}{}, err
+		},
+	}.Poll(ctx)
+	if err == nil {
+		return nil
+	}
+
+	detail := err.Error()
+
+	var timeoutErr *util.PollTimeoutError
+	if errors.As(err, &timeoutErr) {
+		if serr := DiagnosticFromError(timeoutErr.LastErr); serr != nil {
+			detail = fmt.Sprintf("The query %q did not succeed within %s. The last error: %s: %s",
+				readyQuery, timeout, serr.Summary, serr.Detail,
+			)
+		}
+	}
+
+	return &util.SummaryWithDetailError{
+		Summary: fmt.Sprintf("The Data API of the workspace %s is not ready", endpoint),
+		Detail:  detail,
+	}
+}
@@ -0,0 +1,119 @@
+package sql_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// notReadyThenReadyHandler responds with 503 to the given number of queries and then answers SELECT 1.
+func notReadyThenReadyHandler(t *testing.T, failures int32, queryCalls *atomic.Int32) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != testutil.DataAPIQueryPath {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"sql":"SELECT 1"}`, string(body))
+
+		if queryCalls.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err = w.Write([]byte(`{"results":[{"columns":[{"name":"1"}],"rows":[[1]]}]}`))
+		require.NoError(t, err)
+	})
+}
+
+func TestWaitForDataAPIRetriesUntilReady(t *testing.T) {
+	var queryCalls atomic.Int32
+	dataAPI := testutil.MockDataAPIServer(t, notReadyThenReadyHandler(t, 2, &queryCalls))
+
+	client := sql.NewClientForTest(dataAPI(), "https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+	serr := sql.WaitForDataAPIForTest(t.Context(), client, testutil.TestWorkspaceEndpoint, time.Minute)
+	require.Nil(t, serr)
+	require.Equal(t, int32(2+config.DataAPIReadyConsistencyThreshold), queryCalls.Load())
+}
+
+func TestWaitForDataAPITimeout(t *testing.T) {
+	var queryCalls atomic.Int32
+	dataAPI := testutil.MockDataAPIServer(t, notReadyThenReadyHandler(t, 1000, &queryCalls))
+
+	client := sql.NewClientForTest(dataAPI(), "https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+	serr := sql.WaitForDataAPIForTest(t.Context(), client, testutil.TestWorkspaceEndpoint, time.Second)
+	require.NotNil(t, serr)
+	require.Contains(t, serr.Summary, "is not ready")
+	require.Contains(t, serr.Detail, "Could not reach the SingleStore Data API")
+}
+
+func TestDataAPIReadyCreate(t *testing.T) {
+	var queryCalls atomic.Int32
+	dataAPI := testutil.MockDataAPIServer(t, notReadyThenReadyHandler(t, 1, &queryCalls))
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_data_api_ready" "this" {
+  endpoint = %q
+  username = "admin"
+  password = "secret"
+}
+`, testutil.TestWorkspaceEndpoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("singlestoredb_data_api_ready.this", config.IDAttribute),
+					resource.TestCheckResourceAttr("singlestoredb_data_api_ready.this", "endpoint", testutil.TestWorkspaceEndpoint),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, int32(1+config.DataAPIReadyConsistencyThreshold), queryCalls.Load(), "only create should query the Data API")
+}
+
+func TestDataAPIReadyResourceIntegration(t *testing.T) {
+	adminPassword := testutil.TestAdminPassword
+
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.DataAPIReadyResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(adminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("singlestoredb_data_api_ready.this", config.IDAttribute),
+					resource.TestCheckResourceAttrSet("singlestoredb_sql_execute.this", config.IDAttribute),
+				),
+			},
+		},
+	})
+}
@@ -4,17 +4,23 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 )
@@ -22,28 +28,50 @@ import (
 const ResourceName = "sql_execute"
 
 var (
-	_ resource.Resource                = &sqlExecuteResource{}
-	_ resource.ResourceWithModifyPlan  = &sqlExecuteResource{}
-	_ resource.ResourceWithImportState = &sqlExecuteResource{}
+	_ resource.Resource                   = &sqlExecuteResource{}
+	_ resource.ResourceWithModifyPlan     = &sqlExecuteResource{}
+	_ resource.ResourceWithImportState    = &sqlExecuteResource{}
+	_ resource.ResourceWithValidateConfig = &sqlExecuteResource{}
+	_ resource.ResourceWithUpgradeState   = &sqlExecuteResource{}
+	_ resource.ResourceWithConfigure      = &sqlExecuteResource{}
 )
 
 type sqlExecuteResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Endpoint     types.String `tfsdk:"endpoint"`
-	Username     types.String `tfsdk:"username"`
-	Password     types.String `tfsdk:"password"`
-	Database     types.String `tfsdk:"database"`
-	Execute      types.String `tfsdk:"execute"`
-	ExecuteArgs  types.List   `tfsdk:"execute_args"`
-	Revert       types.String `tfsdk:"revert"`
-	Query        types.String `tfsdk:"query"`
-	QueryArgs    types.List   `tfsdk:"query_args"`
-	QueryResults types.List   `tfsdk:"query_results"`
-	LastInsertID types.Int64  `tfsdk:"last_insert_id"`
-	RowsAffected types.Int64  `tfsdk:"rows_affected"`
+	ID         types.String `tfsdk:"id"`
+	Endpoint   types.String `tfsdk:"endpoint"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Protocol   types.String `tfsdk:"protocol"`
+	AutoResume types.Bool   `tfsdk:"auto_resume"`
+	// ValidateOnPlan runs EXPLAIN for the statements of a plan that changes them.
+	ValidateOnPlan types.Bool    `tfsdk:"validate_on_plan"`
+	WorkspaceID    types.String  `tfsdk:"workspace_id"`
+	Database       types.String  `tfsdk:"database"`
+	Execute        types.Dynamic `tfsdk:"execute"`
+	ExecuteArgs    types.List    `tfsdk:"execute_args"`
+	Revert         types.Dynamic `tfsdk:"revert"`
+	Query          types.String  `tfsdk:"query"`
+	QueryArgs      types.List    `tfsdk:"query_args"`
+	// QueryMaxRows and QueryMaxBytes limit the rows of query kept in state.
+	QueryMaxRows  types.Int64 `tfsdk:"query_max_rows"`
+	QueryMaxBytes types.Int64 `tfsdk:"query_max_bytes"`
+	QueryResults  types.List  `tfsdk:"query_results"`
+	// ExpectedResults are the query_results that mean the object of execute exists.
+	ExpectedResults types.List `tfsdk:"expected_results"`
+	// QueryTypedResults and QueryColumns are query_results with the value types and the column order kept.
+	QueryTypedResults types.Dynamic `tfsdk:"query_typed_results"`
+	QueryColumns      types.List    `tfsdk:"query_columns"`
+	QueryResultSets   types.Dynamic `tfsdk:"query_result_sets"`
+	LastInsertID      types.Int64   `tfsdk:"last_insert_id"`
+	RowsAffected      types.Int64   `tfsdk:"rows_affected"`
+	// ExecutedStatements counts the execute statements that succeeded, which are the ones that revert undoes.
+	ExecutedStatements types.Int64 `tfsdk:"executed_statements"`
 }
 
-type sqlExecuteResource struct{}
+type sqlExecuteResource struct {
+	management.ClientWithResponsesInterface
+	Connector
+}
 
 func NewResource() resource.Resource {
 	return &sqlExecuteResource{}
@@ -55,9 +83,10 @@ func (r *sqlExecuteResource) Metadata(_ context.Context, req resource.MetadataRe
 
 func (r *sqlExecuteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Execute SQL statements against a SingleStore Helios workspace via the Data API. " +
+		Version: 1,
+		MarkdownDescription: "Execute SQL statements against a SingleStore Helios workspace via the Data API or the MySQL protocol. " +
 			"Use for DDL and DML with optional read-back for drift detection. " +
-			"Requires HTTPS access to the workspace host on port 443.",
+			"Requires HTTPS access to the workspace host on port 443, or access on port 3306 with `protocol = \"mysql\"`.",
 		Attributes: map[string]schema.Attribute{
 			config.IDAttribute: schema.StringAttribute{
 				Computed:            true,
@@ -68,7 +97,7 @@ func (r *sqlExecuteResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"endpoint": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Workspace SQL endpoint (bare host). Typically `singlestoredb_workspace.<n>.endpoint`. Must not include a port; the Data API uses HTTPS on port 443.",
+				MarkdownDescription: "Workspace SQL endpoint (bare host). Typically `singlestoredb_workspace.<n>.endpoint`. Must not include a port; the port follows from `protocol`.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -85,6 +114,24 @@ func (r *sqlExecuteResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Sensitive:           true,
 				MarkdownDescription: fmt.Sprintf("SQL user password or JWT when `username` is `*`. Falls back to `%s` when unset.", config.EnvSQLUserPassword),
 			},
+			"protocol": protocolAttribute(),
+			"auto_resume": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: autoResumeDescription,
+			},
+			"validate_on_plan": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Validate changed SQL during plan by running `EXPLAIN` for it, so that syntax errors and missing tables or columns " +
+					"fail the plan rather than the apply. `execute` is validated when the resource is created or replaced, and `revert` and `query` when they change otherwise. " +
+					"Only statements that `EXPLAIN` supports (`SELECT`, `WITH`, `INSERT`, `REPLACE`, `UPDATE`, and `DELETE`) are validated, " +
+					"up to the first statement of a list that it does not support, such as DDL whose effects later statements may depend on; the plan warns about the statements that are not validated. " +
+					"Requires a known `endpoint` and credentials; the plan only warns when the workspace is unreachable, and `auto_resume` does not resume it during plan. Defaults to `false`.",
+			},
+			"workspace_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: workspaceIDDescription,
+				Validators:          []validator.String{util.NewUUIDValidator()},
+			},
 			"database": schema.StringAttribute{
 				Optional: true,
 				MarkdownDescription: "Context database for execute, revert, and query. " +
@@ -93,53 +140,106 @@ func (r *sqlExecuteResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"execute": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "SQL statement run on create. Changing this value forces replacement.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+			"execute": schema.DynamicAttribute{
+				Required: true,
+				MarkdownDescription: "SQL statement run on create, or a list of statements run in order. " +
+					"The statements stop at the first failure; the number of succeeded statements is saved as `executed_statements` " +
+					"and the resource is tainted, so the next apply reverts them before running `execute` again. " +
+					"Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.RequiresReplace(),
 				},
 			},
 			"execute_args": schema.ListAttribute{
 				Optional:            true,
 				Sensitive:           true,
 				ElementType:         types.StringType,
-				MarkdownDescription: "Positional arguments for `?` placeholders in `execute`. Changing this value forces replacement.",
+				MarkdownDescription: "Positional arguments for `?` placeholders in `execute`. Requires a single `execute` statement. Changing this value forces replacement.",
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.RequiresReplace(),
 				},
 			},
-			"revert": schema.StringAttribute{
+			"revert": schema.DynamicAttribute{
 				Required: true,
-				MarkdownDescription: "SQL statement run on destroy. Required so destroy is meaningful. " +
+				MarkdownDescription: "SQL statement run on destroy, or a list of statements run in reverse order. Required so destroy is meaningful. " +
 					"Must undo the effects of `execute`; changing `revert` in place does not re-run `execute` " +
 					"and the new value is used on the next destroy only. When retargeting to a different object, " +
-					"change `execute` and `revert` in the same apply so replacement destroy runs the old revert.",
+					"change `execute` and `revert` in the same apply so replacement destroy runs the old revert. " +
+					"When both `execute` and `revert` are lists, they must have the same length: each `revert` statement undoes " +
+					"the `execute` statement at the same position, and only the `revert` statements of the succeeded `execute` statements run.",
 			},
 			"query": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Optional read-back SQL. Re-executed on every read; results exposed as `query_results`.",
+				MarkdownDescription: "Optional read-back SQL. Re-executed on every read; results exposed as `query_results`, `query_typed_results`, `query_columns`, and `query_result_sets`.",
 			},
 			"query_args": schema.ListAttribute{
 				Optional:            true,
 				ElementType:         types.StringType,
 				MarkdownDescription: "Positional arguments for `?` placeholders in `query`.",
 			},
+			"query_max_rows": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: maxRowsDescription("query"),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"query_max_bytes": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: maxBytesDescription("query"),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"expected_results": schema.ListAttribute{
+				Optional:    true,
+				ElementType: QueryResultsElementType,
+				MarkdownDescription: "Rows that `query_results` should equal, in order, while the effects of `execute` are in place. " +
+					"When a refresh reads other results, e.g., because the table created by `execute` was dropped outside of Terraform, " +
+					"the plan replaces the resource: `revert` runs and then `execute` restores the object. " +
+					"A failing `query` does not count as a mismatch. Requires `query`.",
+			},
 			"query_results": schema.ListAttribute{
 				Computed:            true,
 				ElementType:         QueryResultsElementType,
-				MarkdownDescription: "Rows from the first result set of `query`. All values are strings. Empty when `query` is unset or fails.",
+				MarkdownDescription: "Rows from the first result set of `query`. All values are strings, and `NULL` is an empty string. Empty when `query` is unset or fails.",
+			},
+			"query_typed_results": schema.DynamicAttribute{
+				Computed: true,
+				MarkdownDescription: "Rows from the first result set of `query` as a list of objects with an attribute per column. " +
+					"Numeric columns are numbers without loss of precision, boolean values are bools, `NULL` is `null`, and all other values are strings. " +
+					"Empty when `query` is unset or fails.",
+			},
+			"query_columns": schema.ListAttribute{
+				Computed:    true,
+				ElementType: ColumnsElementType,
+				MarkdownDescription: "Columns of the first result set of `query` in select-list order, with their `name`, SQL `type`, and whether they are `nullable`. " +
+					"`type` is null when the Data API does not report column metadata. Empty when `query` is unset or fails.",
+			},
+			"query_result_sets": schema.DynamicAttribute{
+				Computed: true,
+				MarkdownDescription: "Every result set of `query` in order, e.g., of several `SELECT` statements separated by semicolons. " +
+					"Each result set is an object with `rows`, `typed_rows`, and `columns` like `query_results`, `query_typed_results`, and `query_columns`. " +
+					"Empty when `query` is unset or fails.",
 			},
 			"last_insert_id": schema.Int64Attribute{
 				Computed:            true,
-				MarkdownDescription: "Last insert ID from the Data API exec response (0 when not applicable).",
+				MarkdownDescription: "Last insert ID from the Data API exec response of the last `execute` statement (0 when not applicable).",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"rows_affected": schema.Int64Attribute{
 				Computed:            true,
-				MarkdownDescription: "Rows affected from the Data API exec response.",
+				MarkdownDescription: "Rows affected from the Data API exec response, summed over the `execute` statements.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"executed_statements": schema.Int64Attribute{
+				Computed: true,
+				MarkdownDescription: "The number of `execute` statements that succeeded. " +
+					"Less than the number of statements after a partial failure, and decreased as paired `revert` statements succeed on destroy.",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -148,6 +248,50 @@ func (r *sqlExecuteResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *sqlExecuteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var conf sqlExecuteResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	executes, executeDiags := statementList(conf.Execute, path.Root("execute"))
+	reverts, revertDiags := statementList(conf.Revert, path.Root("revert"))
+	resp.Diagnostics.Append(executeDiags...)
+	resp.Diagnostics.Append(revertDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(ValidateAutoResume(conf.AutoResume, conf.WorkspaceID)...)
+
+	if !conf.ExpectedResults.IsNull() && conf.Query.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_results"),
+			"Invalid configuration",
+			"expected_results requires query, whose results are compared with it.",
+		)
+	}
+
+	if len(executes) > 1 && !conf.ExecuteArgs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("execute_args"),
+			"Invalid configuration",
+			"execute_args requires a single execute statement; inline the values of a list of statements instead.",
+		)
+	}
+
+	if isStatementList(conf.Execute) && isStatementList(conf.Revert) && executes != nil && reverts != nil && len(executes) != len(reverts) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("revert"),
+			"Invalid configuration",
+			fmt.Sprintf("revert lists %d statements but execute lists %d. Each revert statement undoes the execute statement at the same position.",
+				len(reverts), len(executes),
+			),
+		)
+	}
+}
+
 func (r *sqlExecuteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan sqlExecuteResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -162,36 +306,65 @@ func (r *sqlExecuteResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	client, serr := buildClient(plan.Endpoint.ValueString(), plan.Username.ValueString(), password)
+	client, serr := r.buildClient(plan.Endpoint.ValueString(), plan.Username.ValueString(), password, plan.Protocol.ValueString())
 	if serr != nil {
 		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
 
 		return
 	}
 
+	if serr := r.autoResume(plan).Resume(ctx, client, plan.Endpoint.ValueString()); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
 	executeArgs, diags := ListStrings(ctx, plan.ExecuteArgs)
 	resp.Diagnostics.Append(diags...)
+	statements, diags := statementList(plan.Execute, path.Root("execute"))
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	execResp, err := client.Exec(ctx, ExecRequest{
-		SQL:      plan.Execute.ValueString(),
-		Args:     StringArgsToAny(executeArgs),
-		Database: plan.Database.ValueString(),
-	})
-	if err != nil {
-		serr := DiagnosticFromError(err)
-		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+	var lastInsertID, rowsAffected int64
+
+	executed := 0
+	for _, statement := range statements {
+		execResp, err := client.Exec(ctx, ExecRequest{
+			SQL:      statement,
+			Args:     StringArgsToAny(executeArgs),
+			Database: plan.Database.ValueString(),
+		})
+		if err != nil {
+			serr := DiagnosticFromError(err)
+			if executed == 0 {
+				resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+				return
+			}
+
+			// The succeeded statements are saved, so that Terraform taints the resource
+			// and the next apply reverts exactly those statements before running execute again.
+			resp.Diagnostics.AddError(serr.Summary, fmt.Sprintf(
+				"Statement %d of %d failed: %s The %d statements before it succeeded and are reverted when the resource is replaced or destroyed.",
+				executed+1, len(statements), serr.Detail, executed,
+			))
+
+			break
+		}
 
-		return
+		lastInsertID = execResp.LastInsertID
+		rowsAffected += execResp.RowsAffected
+		executed++
 	}
 
 	plan.ID = types.StringValue(uuid.NewString())
-	plan.LastInsertID = types.Int64Value(execResp.LastInsertID)
-	plan.RowsAffected = types.Int64Value(execResp.RowsAffected)
+	plan.LastInsertID = types.Int64Value(lastInsertID)
+	plan.RowsAffected = types.Int64Value(rowsAffected)
+	plan.ExecutedStatements = types.Int64Value(int64(executed))
 	plan.Password = passwordForState(plan.Password)
-	plan.QueryResults = EmptyQueryResults()
+	plan.setQueryResult(emptyQueryResult())
 
 	// Persist the executed resource before running the optional read-back query.
 	// execute has already mutated the workspace, so a query failure must not
@@ -201,12 +374,21 @@ func (r *sqlExecuteResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	queryResults, readDiags := r.readQueryFromModel(ctx, client, plan, queryFailureIsError)
+	result, readDiags := r.readQueryFromModel(ctx, client, plan, queryFailureIsError)
 	resp.Diagnostics.Append(readDiags...)
 	if readDiags.HasError() {
 		return
 	}
-	plan.QueryResults = queryResults
+	plan.setQueryResult(result)
+
+	// The resource is saved, so the error taints it and the next apply runs revert and execute again.
+	if queryResultsDrifted(plan.ExpectedResults, plan.QueryResults) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_results"),
+			"Query results do not match expected_results",
+			"query returned other rows than expected_results right after execute. Check that execute creates what query looks for, and that expected_results lists every row of query_results in order.",
+		)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -219,7 +401,7 @@ func (r *sqlExecuteResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if state.Query.IsNull() || state.Query.ValueString() == "" {
-		state.QueryResults = EmptyQueryResults()
+		state.setQueryResult(emptyQueryResult())
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 		return
@@ -232,16 +414,26 @@ func (r *sqlExecuteResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	client, serr := buildClient(state.Endpoint.ValueString(), state.Username.ValueString(), password)
+	client, serr := r.buildClient(state.Endpoint.ValueString(), state.Username.ValueString(), password, state.Protocol.ValueString())
 	if serr != nil {
 		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
 
 		return
 	}
 
-	queryResults, readDiags := r.readQueryFromModel(ctx, client, state, queryFailureIsWarning)
+	if serr := r.autoResume(state).Resume(ctx, client, state.Endpoint.ValueString()); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	result, readDiags := r.readQueryFromModel(ctx, client, state, queryFailureIsWarning)
 	resp.Diagnostics.Append(readDiags...)
-	state.QueryResults = queryResults
+
+	// A failed query keeps the results compared with expected_results, so that a transient failure does not plan a replacement.
+	if !result.Failed || state.ExpectedResults.IsNull() {
+		state.setQueryResult(result)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -260,10 +452,15 @@ func (r *sqlExecuteResource) Update(ctx context.Context, req resource.UpdateRequ
 	// switch back to the SINGLESTORE_SQL_USER_PASSWORD fallback.
 	state.Password = passwordForState(plan.Password)
 
+	state.Protocol = plan.Protocol
+	state.AutoResume = plan.AutoResume
+	state.ValidateOnPlan = plan.ValidateOnPlan
+	state.WorkspaceID = plan.WorkspaceID
 	state.Database = plan.Database
 	state.Revert = plan.Revert
 	state.Query = plan.Query
 	state.QueryArgs = plan.QueryArgs
+	state.ExpectedResults = plan.ExpectedResults
 
 	password, serr := resolvePassword(state.Password)
 	if serr != nil {
@@ -272,16 +469,22 @@ func (r *sqlExecuteResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	client, serr := buildClient(state.Endpoint.ValueString(), state.Username.ValueString(), password)
+	client, serr := r.buildClient(state.Endpoint.ValueString(), state.Username.ValueString(), password, state.Protocol.ValueString())
 	if serr != nil {
 		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
 
 		return
 	}
 
-	queryResults, readDiags := r.readQueryFromModel(ctx, client, state, queryFailureIsError)
+	if serr := r.autoResume(state).Resume(ctx, client, state.Endpoint.ValueString()); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	result, readDiags := r.readQueryFromModel(ctx, client, state, queryFailureIsError)
 	resp.Diagnostics.Append(readDiags...)
-	state.QueryResults = queryResults
+	state.setQueryResult(result)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -293,6 +496,13 @@ func (r *sqlExecuteResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Without revert statements there is nothing to run, so a suspended workspace is not resumed.
+	statements, diags := revertStatements(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(statements) == 0 {
+		return
+	}
+
 	password, serr := resolvePassword(state.Password)
 	if serr != nil {
 		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
@@ -300,18 +510,29 @@ func (r *sqlExecuteResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	client, serr := buildClient(state.Endpoint.ValueString(), state.Username.ValueString(), password)
+	client, serr := r.buildClient(state.Endpoint.ValueString(), state.Username.ValueString(), password, state.Protocol.ValueString())
 	if serr != nil {
 		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
 
 		return
 	}
 
-	_, err := client.Exec(ctx, ExecRequest{
-		SQL:      state.Revert.ValueString(),
-		Database: state.Database.ValueString(),
-	})
-	if err != nil {
+	if serr := r.autoResume(state).Resume(ctx, client, state.Endpoint.ValueString()); serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	// Revert undoes execute from the last statement to the first.
+	for i := len(statements) - 1; i >= 0; i-- {
+		_, err := client.Exec(ctx, ExecRequest{
+			SQL:      statements[i],
+			Database: state.Database.ValueString(),
+		})
+		if err == nil {
+			continue
+		}
+
 		// Deliberate trade-off: when the workspace is unreachable we let destroy
 		// succeed so a deleted/suspended workspace does not wedge `terraform destroy`.
 		// IsUnreachable also matches transient network failures, so a blip here can
@@ -322,12 +543,44 @@ func (r *sqlExecuteResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 
 		serr := DiagnosticFromError(err)
-		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+		if len(statements) == 1 {
+			resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(serr.Summary, fmt.Sprintf(
+			"Revert statement %d of %d failed: %s The statements after it succeeded.", i+1, len(statements), serr.Detail,
+		))
+
+		// The kept state records the execute statements that are not reverted yet, so that the next destroy
+		// does not run the succeeded revert statements again.
+		if isPairedRevert(state) {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("executed_statements"), int64(i+1))...)
+		}
 
 		return
 	}
 }
 
+// Configure adds the provider configured client to the resource.
+func (r *sqlExecuteResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Should not return an error for unknown reasons.
+	}
+
+	r.ClientWithResponsesInterface = req.ProviderData.(management.ClientWithResponsesInterface)
+	r.Connector = NewConnector(req.ProviderData)
+}
+
+func (r *sqlExecuteResource) autoResume(model sqlExecuteResourceModel) AutoResume {
+	return AutoResume{
+		Client:      r.ClientWithResponsesInterface,
+		Enabled:     model.AutoResume,
+		WorkspaceID: model.WorkspaceID,
+	}
+}
+
 func (r *sqlExecuteResource) ImportState(_ context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.AddError(
 		"Import not supported",
@@ -335,30 +588,210 @@ func (r *sqlExecuteResource) ImportState(_ context.Context, _ resource.ImportSta
 	)
 }
 
+// sqlExecuteResourceModelV0 is the model of the schema version 0, whose execute and revert are single statements.
+type sqlExecuteResourceModelV0 struct {
+	ID           types.String `tfsdk:"id"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Database     types.String `tfsdk:"database"`
+	Execute      types.String `tfsdk:"execute"`
+	ExecuteArgs  types.List   `tfsdk:"execute_args"`
+	Revert       types.String `tfsdk:"revert"`
+	Query        types.String `tfsdk:"query"`
+	QueryArgs    types.List   `tfsdk:"query_args"`
+	QueryResults types.List   `tfsdk:"query_results"`
+	LastInsertID types.Int64  `tfsdk:"last_insert_id"`
+	RowsAffected types.Int64  `tfsdk:"rows_affected"`
+}
+
+func (r *sqlExecuteResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					config.IDAttribute: schema.StringAttribute{Computed: true},
+					"endpoint":         schema.StringAttribute{Required: true},
+					"username":         schema.StringAttribute{Required: true},
+					"password":         schema.StringAttribute{Optional: true, Sensitive: true},
+					"database":         schema.StringAttribute{Optional: true},
+					"execute":          schema.StringAttribute{Required: true},
+					"execute_args":     schema.ListAttribute{Optional: true, Sensitive: true, ElementType: types.StringType},
+					"revert":           schema.StringAttribute{Required: true},
+					"query":            schema.StringAttribute{Optional: true},
+					"query_args":       schema.ListAttribute{Optional: true, ElementType: types.StringType},
+					"query_results":    schema.ListAttribute{Computed: true, ElementType: QueryResultsElementType},
+					"last_insert_id":   schema.Int64Attribute{Computed: true},
+					"rows_affected":    schema.Int64Attribute{Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior sqlExecuteResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// A single execute statement was either executed, or the resource was not saved.
+				// The typed results are read on the next refresh.
+				resp.Diagnostics.Append(resp.State.Set(ctx, sqlExecuteResourceModel{
+					ID:                 prior.ID,
+					Endpoint:           prior.Endpoint,
+					Username:           prior.Username,
+					Password:           prior.Password,
+					Protocol:           types.StringNull(),
+					AutoResume:         types.BoolNull(),
+					ValidateOnPlan:     types.BoolNull(),
+					WorkspaceID:        types.StringNull(),
+					Database:           prior.Database,
+					Execute:            types.DynamicValue(prior.Execute),
+					ExecuteArgs:        prior.ExecuteArgs,
+					Revert:             types.DynamicValue(prior.Revert),
+					Query:              prior.Query,
+					QueryArgs:          prior.QueryArgs,
+					QueryMaxRows:       types.Int64Null(),
+					QueryMaxBytes:      types.Int64Null(),
+					QueryResults:       prior.QueryResults,
+					ExpectedResults:    types.ListNull(QueryResultsElementType),
+					QueryTypedResults:  types.DynamicNull(),
+					QueryColumns:       types.ListNull(ColumnsElementType),
+					QueryResultSets:    types.DynamicNull(),
+					LastInsertID:       prior.LastInsertID,
+					RowsAffected:       prior.RowsAffected,
+					ExecutedStatements: types.Int64Value(1),
+				})...)
+			},
+		},
+	}
+}
+
 func (r *sqlExecuteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	var plan, state sqlExecuteResourceModel
+	var plan sqlExecuteResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var state *sqlExecuteResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &sqlExecuteResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.ValidateOnPlan.ValueBool() {
+		resp.Diagnostics.Append(r.validatePlannedSQL(ctx, plan, state)...)
+	}
+
+	if state == nil {
+		return
+	}
+
 	// Replacement on execute/execute_args/database changes is enforced by the
 	// RequiresReplace plan modifiers on those attributes so Terraform can
 	// schedule a normal destroy-and-recreate (revert runs against the original
 	// database) in a single apply.
-	if modifyPlanQueryChanged(ctx, plan, state) {
-		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("query_results"), types.ListUnknown(QueryResultsElementType))...)
+	switch {
+	case modifyPlanQueryChanged(ctx, plan, *state):
+		resp.Diagnostics.Append(planUnknownQueryResult(ctx, resp)...)
+	case queryResultsDrifted(plan.ExpectedResults, state.QueryResults):
+		// Terraform replaces the resource only for a path whose planned value differs from the state,
+		// which the unknown query_results does.
+		resp.Diagnostics.Append(planUnknownQueryResult(ctx, resp)...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("query_results"))
+	}
+}
+
+// validatePlannedSQL runs EXPLAIN for the SQL that the plan runs against the current objects of the workspace.
+// execute runs when the resource is created or replaced. query and revert are validated only when they change
+// otherwise, because on creation they reference the objects that execute creates.
+func (r *sqlExecuteResource) validatePlannedSQL(ctx context.Context, plan sqlExecuteResourceModel, state *sqlExecuteResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.Endpoint.IsUnknown() || plan.Username.IsUnknown() || plan.Password.IsUnknown() ||
+		plan.Protocol.IsUnknown() || plan.Database.IsUnknown() {
+		return diags
+	}
+
+	password, serr := resolvePassword(plan.Password)
+	if serr != nil {
+		return diags
+	}
+
+	// Invalid endpoints and protocols are reported by create.
+	client, serr := r.buildClient(plan.Endpoint.ValueString(), plan.Username.ValueString(), password, plan.Protocol.ValueString())
+	if serr != nil {
+		return diags
+	}
+
+	database := plan.Database.ValueString()
+	validate := func(attribute string, statements []string, args types.List) {
+		if diags.HasError() || diags.WarningsCount() > 0 || args.IsUnknown() {
+			return
+		}
+
+		argStrings, argDiags := ListStrings(ctx, args)
+		if argDiags.HasError() {
+			return
+		}
+
+		diags.Append(explainStatements(ctx, client, database, path.Root(attribute), statements, StringArgsToAny(argStrings))...)
+	}
+
+	replaced := state == nil || !plan.Execute.Equal(state.Execute) || executeArgsDiffer(ctx, state.ExecuteArgs, plan.ExecuteArgs) ||
+		!plan.Database.Equal(state.Database) || !plan.Endpoint.Equal(state.Endpoint)
+	if replaced {
+		executes, _ := statementList(plan.Execute, path.Root("execute"))
+		validate("execute", executes, plan.ExecuteArgs)
+
+		return diags
 	}
+
+	if !plan.Revert.Equal(state.Revert) {
+		reverts, _ := statementList(plan.Revert, path.Root("revert"))
+		// revert runs from the last statement to the first.
+		slices.Reverse(reverts)
+		validate("revert", reverts, types.ListNull(types.StringType))
+	}
+
+	if !plan.Query.IsUnknown() && plan.Query.ValueString() != "" && modifyPlanQueryChanged(ctx, plan, *state) {
+		validate("query", []string{plan.Query.ValueString()}, plan.QueryArgs)
+	}
+
+	return diags
+}
+
+func planUnknownQueryResult(ctx context.Context, resp *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	unknown := unknownQueryResult()
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("query_results"), unknown.Rows)...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("query_typed_results"), unknown.TypedRows)...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("query_columns"), unknown.Columns)...)
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("query_result_sets"), unknown.ResultSets)...)
+
+	return diags
+}
+
+// queryResultsDrifted reports whether expected_results is set and the known query results differ from it.
+func queryResultsDrifted(expected, results types.List) bool {
+	if expected.IsNull() || expected.IsUnknown() || results.IsNull() || results.IsUnknown() {
+		return false
+	}
+
+	return !expected.Equal(results)
 }
 
 func modifyPlanQueryChanged(ctx context.Context, plan, state sqlExecuteResourceModel) bool {
 	return !plan.Query.Equal(state.Query) ||
-		executeArgsDiffer(ctx, state.QueryArgs, plan.QueryArgs)
+		executeArgsDiffer(ctx, state.QueryArgs, plan.QueryArgs) ||
+		NewQueryLimits(plan.QueryMaxRows, plan.QueryMaxBytes) != NewQueryLimits(state.QueryMaxRows, state.QueryMaxBytes)
 }
 
 // queryFailureMode controls how a failed read-back query surfaces. On create and
@@ -371,27 +804,29 @@ const (
 	queryFailureIsWarning queryFailureMode = true
 )
 
-func (r *sqlExecuteResource) readQueryFromModel(ctx context.Context, client *Client, model sqlExecuteResourceModel, onFailure queryFailureMode) (types.List, diag.Diagnostics) {
+func (r *sqlExecuteResource) readQueryFromModel(ctx context.Context, client *Client, model sqlExecuteResourceModel, onFailure queryFailureMode) (queryResult, diag.Diagnostics) {
 	if model.Query.IsNull() || model.Query.ValueString() == "" {
-		return EmptyQueryResults(), nil
+		return emptyQueryResult(), nil
 	}
 
 	queryArgs, diags := ListStrings(ctx, model.QueryArgs)
 	if diags.HasError() {
-		return EmptyQueryResults(), diags
+		return emptyQueryResult(), diags
 	}
 
-	return readQuery(ctx, client, model.Database.ValueString(), model.Query.ValueString(), StringArgsToAny(queryArgs), onFailure)
+	limits := NewQueryLimits(model.QueryMaxRows, model.QueryMaxBytes)
+
+	return readQuery(ctx, client, model.Database.ValueString(), model.Query.ValueString(), StringArgsToAny(queryArgs), limits, onFailure)
 }
 
-func readQuery(ctx context.Context, client *Client, database, query string, queryArgs []any, onFailure queryFailureMode) (types.List, diag.Diagnostics) {
+func readQuery(ctx context.Context, client *Client, database, query string, queryArgs []any, limits QueryLimits, onFailure queryFailureMode) (queryResult, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	resp, err := client.QueryRows(ctx, ExecRequest{
+	resp, err := client.QueryRowsWithLimits(ctx, ExecRequest{
 		SQL:      query,
 		Args:     queryArgs,
 		Database: database,
-	})
+	}, limits)
 	if err != nil {
 		serr := DiagnosticFromError(err)
 		if onFailure == queryFailureIsWarning {
@@ -400,29 +835,126 @@ func readQuery(ctx context.Context, client *Client, database, query string, quer
 			diags.AddError(serr.Summary, serr.Detail)
 		}
 
-		return EmptyQueryResults(), diags
-	}
+		result := emptyQueryResult()
+		result.Failed = true
 
-	stringified, err := StringifyRows(firstResultSetRows(resp))
-	if err != nil {
-		diags.AddError("Failed to parse query results", err.Error())
+		return result, diags
+	}
 
-		return EmptyQueryResults(), diags
+	if resp.Truncated {
+		serr := TruncatedDiagnostic(limits, "query_max_rows", "query_max_bytes")
+		diags.AddWarning(serr.Summary, serr.Detail)
 	}
 
-	list, listDiags := RowsToTFList(ctx, stringified)
-	diags.Append(listDiags...)
+	result, resultDiags := newQueryResult(ctx, resp)
+	diags.Append(resultDiags...)
+
+	return result, diags
+}
 
-	return list, diags
+func (m *sqlExecuteResourceModel) setQueryResult(result queryResult) {
+	m.QueryResults = result.Rows
+	m.QueryTypedResults = result.TypedRows
+	m.QueryColumns = result.Columns
+	m.QueryResultSets = result.ResultSets
 }
 
-func buildClient(endpoint, username, password string) (*Client, *util.SummaryWithDetailError) {
+func (c Connector) buildClient(endpoint, username, password, protocol string) (*Client, *util.SummaryWithDetailError) {
 	baseURL, err := DataAPIURL(endpoint)
 	if err != nil {
 		return nil, InvalidEndpointDiagnostic(err)
 	}
 
-	return NewClient(baseURL, username, password), nil
+	if protocol != ProtocolMySQL {
+		return newClient(c.httpClient(), baseURL, username, password), nil
+	}
+
+	if username == "*" {
+		return nil, &util.SummaryWithDetailError{
+			Summary: "JWT authentication requires the Data API",
+			Detail:  "Username \"*\" authenticates with a JWT through the Data API only. Set protocol to \"https\", or use the name of the SQL user with the JWT as the password.",
+		}
+	}
+
+	return NewMySQLClient(hostFromBaseURL(baseURL), username, password), nil
+}
+
+// statementList returns the statements of execute or revert, which is a string or a list of strings.
+// The result is nil if the value is null or unknown.
+func statementList(value types.Dynamic, attribute path.Path) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() || value.IsUnderlyingValueNull() || value.IsUnderlyingValueUnknown() {
+		return nil, diags
+	}
+
+	var elements []attr.Value
+	switch v := value.UnderlyingValue().(type) {
+	case types.String:
+		elements = []attr.Value{v}
+	case types.List:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	default:
+		diags.AddAttributeError(attribute, "Invalid configuration", "The value must be a string or a list of strings.")
+
+		return nil, diags
+	}
+
+	statements := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, ok := element.(types.String)
+		switch {
+		case !ok:
+			diags.AddAttributeError(attribute, "Invalid configuration", "The value must be a string or a list of strings.")
+
+			return nil, diags
+		case s.IsUnknown():
+			return nil, diags
+		case s.IsNull() || strings.TrimSpace(s.ValueString()) == "":
+			diags.AddAttributeError(attribute, "Invalid configuration", "The statements must not be empty.")
+
+			return nil, diags
+		}
+
+		statements = append(statements, s.ValueString())
+	}
+
+	if len(statements) == 0 {
+		diags.AddAttributeError(attribute, "Invalid configuration", "At least one statement is required.")
+	}
+
+	return statements, diags
+}
+
+func isStatementList(value types.Dynamic) bool {
+	switch value.UnderlyingValue().(type) {
+	case types.List, types.Tuple:
+		return true
+	default:
+		return false
+	}
+}
+
+// isPairedRevert reports whether each revert statement undoes the execute statement at the same position.
+func isPairedRevert(model sqlExecuteResourceModel) bool {
+	executes, executeDiags := statementList(model.Execute, path.Root("execute"))
+	reverts, revertDiags := statementList(model.Revert, path.Root("revert"))
+
+	return !executeDiags.HasError() && !revertDiags.HasError() &&
+		isStatementList(model.Revert) && len(executes) > 1 && len(executes) == len(reverts)
+}
+
+// revertStatements returns the revert statements to run on destroy, in the order of execute.
+// Paired revert statements are limited to the succeeded execute statements.
+func revertStatements(model sqlExecuteResourceModel) ([]string, diag.Diagnostics) {
+	statements, diags := statementList(model.Revert, path.Root("revert"))
+	if diags.HasError() || !isPairedRevert(model) || model.ExecutedStatements.IsNull() || model.ExecutedStatements.IsUnknown() {
+		return statements, diags
+	}
+
+	return statements[:min(int(model.ExecutedStatements.ValueInt64()), len(statements))], diags
 }
 
 func executeArgsDiffer(ctx context.Context, a, b types.List) bool {
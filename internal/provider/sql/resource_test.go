@@ -6,7 +6,6 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
-	"net/url"
 	"os"
 	"regexp"
 	"strings"
@@ -14,6 +13,11 @@ import (
 	"sync/atomic"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
@@ -23,45 +27,6 @@ import (
 	"github.com/zclconf/go-cty/cty"
 )
 
-const (
-	testWorkspaceEndpoint = "workspace.example.com"
-	testAdminPassword     = "sfkjDIJ423d44w1sfooBar1$" //nolint:gosec
-
-	dataAPIExecPath  = "/api/v2/exec"
-	dataAPIQueryPath = "/api/v2/query/rows"
-)
-
-func withMockDataAPIServer(t *testing.T, handler http.Handler) {
-	t.Helper()
-
-	server := httptest.NewServer(handler)
-	target, err := url.Parse(server.URL)
-	require.NoError(t, err)
-
-	restore := sql.SetHTTPClientFactoryForTest(func() *http.Client {
-		return &http.Client{
-			Transport: redirectTransport{target: target},
-		}
-	})
-
-	t.Cleanup(func() {
-		restore()
-		server.Close()
-	})
-}
-
-type redirectTransport struct {
-	target *url.URL
-}
-
-func (rt redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	cloned := req.Clone(req.Context())
-	cloned.URL.Scheme = rt.target.Scheme
-	cloned.URL.Host = rt.target.Host
-
-	return http.DefaultTransport.RoundTrip(cloned)
-}
-
 func sqlExecuteConfig() string {
 	return fmt.Sprintf(`
 provider "singlestoredb" {
@@ -77,7 +42,7 @@ resource "singlestoredb_sql_execute" "this" {
   query        = "SHOW DATABASES LIKE ?"
   query_args   = ["my_app_db"]
 }
-`, testWorkspaceEndpoint)
+`, testutil.TestWorkspaceEndpoint)
 }
 
 func minimalSQLExecuteConfig() string {
@@ -92,19 +57,19 @@ resource "singlestoredb_sql_execute" "this" {
   execute  = "SELECT 1"
   revert   = "SELECT 1"
 }
-`, testWorkspaceEndpoint)
+`, testutil.TestWorkspaceEndpoint)
 }
 
 func TestSQLExecuteCreateReadDestroy(t *testing.T) {
 	var execCalls atomic.Int32
 	var queryCalls atomic.Int32
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
 		require.NoError(t, err)
 
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			execCalls.Add(1)
 			require.Equal(t, http.MethodPost, r.Method)
 
@@ -122,12 +87,12 @@ func TestSQLExecuteCreateReadDestroy(t *testing.T) {
 			w.Header().Set("Content-Type", "application/json")
 			_, err = w.Write([]byte(`{"lastInsertId":7,"rowsAffected":1}`))
 			require.NoError(t, err)
-		case dataAPIQueryPath:
+		case testutil.DataAPIQueryPath:
 			queryCalls.Add(1)
 			require.JSONEq(t, `{"sql":"SHOW DATABASES LIKE ?","args":["my_app_db"]}`, string(body))
 
 			w.Header().Set("Content-Type", "application/json")
-			_, err = w.Write([]byte(`{"results":[{"rows":[{"Database":"my_app_db"}]}]}`))
+			_, err = w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[["my_app_db"]]}]}`))
 			require.NoError(t, err)
 		default:
 			w.WriteHeader(http.StatusNotFound)
@@ -135,7 +100,8 @@ func TestSQLExecuteCreateReadDestroy(t *testing.T) {
 	}))
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -146,6 +112,8 @@ func TestSQLExecuteCreateReadDestroy(t *testing.T) {
 					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "rows_affected", "1"),
 					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "query_results.#", "1"),
 					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "query_results.0.Database", "my_app_db"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "query_typed_results.0.Database", "my_app_db"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "query_columns.0.name", "Database"),
 				),
 			},
 			{
@@ -157,11 +125,11 @@ func TestSQLExecuteCreateReadDestroy(t *testing.T) {
 	})
 
 	require.Equal(t, int32(2), execCalls.Load(), "create and destroy should call /exec")
-	require.GreaterOrEqual(t, queryCalls.Load(), int32(1), "create/read should call /query/rows")
+	require.GreaterOrEqual(t, queryCalls.Load(), int32(1), "create/read should call /query/tuples")
 }
 
 func TestSQLExecutePasswordFromEnvNotInState(t *testing.T) {
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		user, pass, ok := r.BasicAuth()
 		require.True(t, ok)
 		require.Equal(t, "admin", user)
@@ -169,11 +137,11 @@ func TestSQLExecutePasswordFromEnvNotInState(t *testing.T) {
 
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			_, err := w.Write([]byte(`{"lastInsertId":0,"rowsAffected":0}`))
 			require.NoError(t, err)
-		case dataAPIQueryPath:
-			_, err := w.Write([]byte(`{"results":[{"rows":[]}]}`))
+		case testutil.DataAPIQueryPath:
+			_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[]}]}`))
 			require.NoError(t, err)
 		default:
 			w.WriteHeader(http.StatusNotFound)
@@ -193,10 +161,11 @@ resource "singlestoredb_sql_execute" "this" {
   revert   = "SELECT 1"
   query    = "SELECT 1"
 }
-`, testWorkspaceEndpoint)
+`, testutil.TestWorkspaceEndpoint)
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -212,11 +181,11 @@ resource "singlestoredb_sql_execute" "this" {
 func TestSQLExecutePlanReplacementOnExecuteChange(t *testing.T) {
 	var sawExecuteSelect2 atomic.Bool
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
 		require.NoError(t, err)
 
-		if r.URL.Path == dataAPIExecPath && strings.Contains(string(body), "SELECT 2") {
+		if r.URL.Path == testutil.DataAPIExecPath && strings.Contains(string(body), "SELECT 2") {
 			sawExecuteSelect2.Store(true)
 		}
 
@@ -237,10 +206,11 @@ resource "singlestoredb_sql_execute" "this" {
   execute  = "SELECT 2"
   revert   = "SELECT 1"
 }
-`, testWorkspaceEndpoint)
+`, testutil.TestWorkspaceEndpoint)
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{Config: baseConfig},
@@ -261,16 +231,16 @@ resource "singlestoredb_sql_execute" "this" {
 func TestSQLExecuteUpdateInPlace(t *testing.T) {
 	var execCalls atomic.Int32
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			execCalls.Add(1)
 			_, err := w.Write([]byte(`{"lastInsertId":0,"rowsAffected":1}`))
 			require.NoError(t, err)
-		case dataAPIQueryPath:
-			_, err := w.Write([]byte(`{"results":[{"rows":[{"Database":"my_app_db"}]}]}`))
+		case testutil.DataAPIQueryPath:
+			_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[["my_app_db"]]}]}`))
 			require.NoError(t, err)
 		default:
 			w.WriteHeader(http.StatusNotFound)
@@ -291,11 +261,12 @@ resource "singlestoredb_sql_execute" "this" {
   query      = "SHOW DATABASES LIKE ?"
   query_args = ["my_app_db"]
 }
-`, testWorkspaceEndpoint, revert)
+`, testutil.TestWorkspaceEndpoint, revert)
 	}
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -328,13 +299,13 @@ func TestSQLExecuteReplacementOnDatabaseChange(t *testing.T) {
 	var mu sync.Mutex
 	var execs []execCall
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
 		require.NoError(t, err)
 
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			var call execCall
 			require.NoError(t, json.Unmarshal(body, &call))
 			mu.Lock()
@@ -342,7 +313,7 @@ func TestSQLExecuteReplacementOnDatabaseChange(t *testing.T) {
 			mu.Unlock()
 			_, err = w.Write([]byte(`{"lastInsertId":0,"rowsAffected":0}`))
 		default:
-			_, err = w.Write([]byte(`{"results":[{"rows":[]}]}`))
+			_, err = w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[]}]}`))
 		}
 		require.NoError(t, err)
 	}))
@@ -360,11 +331,12 @@ resource "singlestoredb_sql_execute" "this" {
   execute  = "CREATE TABLE t (id INT)"
   revert   = "DROP TABLE t"
 }
-`, testWorkspaceEndpoint, database)
+`, testutil.TestWorkspaceEndpoint, database)
 	}
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{Config: configWithDatabase("db1")},
@@ -398,13 +370,13 @@ resource "singlestoredb_sql_execute" "this" {
 func TestSQLExecuteDestroySucceedsWhenWorkspaceUnreachable(t *testing.T) {
 	var revertCalls atomic.Int32
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
 		require.NoError(t, err)
 
 		// The revert statement (run on destroy) gets a 503, simulating a
 		// suspended/deleted workspace. Destroy must still succeed.
-		if r.URL.Path == dataAPIExecPath && strings.Contains(string(body), "DROP DATABASE") {
+		if r.URL.Path == testutil.DataAPIExecPath && strings.Contains(string(body), "DROP DATABASE") {
 			revertCalls.Add(1)
 			w.WriteHeader(http.StatusServiceUnavailable)
 
@@ -413,16 +385,17 @@ func TestSQLExecuteDestroySucceedsWhenWorkspaceUnreachable(t *testing.T) {
 
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			_, err = w.Write([]byte(`{"lastInsertId":0,"rowsAffected":0}`))
 		default:
-			_, err = w.Write([]byte(`{"results":[{"rows":[]}]}`))
+			_, err = w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[]}]}`))
 		}
 		require.NoError(t, err)
 	}))
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{Config: sqlExecuteConfig()},
@@ -435,11 +408,11 @@ func TestSQLExecuteDestroySucceedsWhenWorkspaceUnreachable(t *testing.T) {
 func TestSQLExecuteDestroyFailsWhenRevertSQLError(t *testing.T) {
 	var revertCalls atomic.Int32
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		body, err := io.ReadAll(r.Body)
 		require.NoError(t, err)
 
-		if r.URL.Path == dataAPIExecPath && strings.Contains(string(body), "DROP DATABASE") {
+		if r.URL.Path == testutil.DataAPIExecPath && strings.Contains(string(body), "DROP DATABASE") {
 			if revertCalls.Add(1) == 1 {
 				w.WriteHeader(http.StatusBadRequest)
 				_, err = w.Write([]byte("cannot drop protected database"))
@@ -456,10 +429,10 @@ func TestSQLExecuteDestroyFailsWhenRevertSQLError(t *testing.T) {
 
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			_, err = w.Write([]byte(`{"lastInsertId":0,"rowsAffected":1}`))
-		case dataAPIQueryPath:
-			_, err = w.Write([]byte(`{"results":[{"rows":[{"Database":"my_app_db"}]}]}`))
+		case testutil.DataAPIQueryPath:
+			_, err = w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[["my_app_db"]]}]}`))
 		default:
 			w.WriteHeader(http.StatusNotFound)
 		}
@@ -467,7 +440,8 @@ func TestSQLExecuteDestroyFailsWhenRevertSQLError(t *testing.T) {
 	}))
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -490,13 +464,13 @@ func TestSQLExecuteDestroyFailsWhenRevertSQLError(t *testing.T) {
 func TestSQLExecuteUpdateFailsWhenQueryFails(t *testing.T) {
 	var failQuery atomic.Bool
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			_, err := w.Write([]byte(`{"lastInsertId":0,"rowsAffected":1}`))
 			require.NoError(t, err)
-		case dataAPIQueryPath:
+		case testutil.DataAPIQueryPath:
 			if failQuery.Load() {
 				_, err := w.Write([]byte(`{"error":{"code":1146,"message":"Table 'missing' doesn't exist"}}`))
 				require.NoError(t, err)
@@ -504,7 +478,7 @@ func TestSQLExecuteUpdateFailsWhenQueryFails(t *testing.T) {
 				return
 			}
 
-			_, err := w.Write([]byte(`{"results":[{"rows":[{"Database":"my_app_db"}]}]}`))
+			_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[["my_app_db"]]}]}`))
 			require.NoError(t, err)
 		default:
 			w.WriteHeader(http.StatusNotFound)
@@ -525,11 +499,12 @@ resource "singlestoredb_sql_execute" "this" {
   query      = "SHOW DATABASES LIKE ?"
   query_args = ["my_app_db"]
 }
-`, testWorkspaceEndpoint, revert)
+`, testutil.TestWorkspaceEndpoint, revert)
 	}
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -550,13 +525,13 @@ resource "singlestoredb_sql_execute" "this" {
 }
 
 func TestSQLExecuteCreateFailsWhenQueryFails(t *testing.T) {
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			_, err := w.Write([]byte(`{"lastInsertId":0,"rowsAffected":0}`))
 			require.NoError(t, err)
-		case dataAPIQueryPath:
+		case testutil.DataAPIQueryPath:
 			// Read-back query fails with an in-body error. On create this is a
 			// configuration error and must fail the apply (not just warn).
 			_, err := w.Write([]byte(`{"error":{"code":1146,"message":"Table 'missing' doesn't exist"}}`))
@@ -567,7 +542,8 @@ func TestSQLExecuteCreateFailsWhenQueryFails(t *testing.T) {
 	}))
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -582,18 +558,18 @@ func TestSQLExecuteUpdatePasswordChange(t *testing.T) {
 	var lastPassword atomic.Value
 	lastPassword.Store("")
 
-	withMockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		_, pass, ok := r.BasicAuth()
 		require.True(t, ok)
 		lastPassword.Store(pass)
 
 		w.Header().Set("Content-Type", "application/json")
 		switch r.URL.Path {
-		case dataAPIExecPath:
+		case testutil.DataAPIExecPath:
 			_, err := w.Write([]byte(`{"lastInsertId":0,"rowsAffected":0}`))
 			require.NoError(t, err)
-		case dataAPIQueryPath:
-			_, err := w.Write([]byte(`{"results":[{"rows":[{"Database":"my_app_db"}]}]}`))
+		case testutil.DataAPIQueryPath:
+			_, err := w.Write([]byte(`{"results":[{"columns":[{"name":"Database"}],"rows":[["my_app_db"]]}]}`))
 			require.NoError(t, err)
 		default:
 			w.WriteHeader(http.StatusNotFound)
@@ -614,11 +590,12 @@ resource "singlestoredb_sql_execute" "this" {
   query      = "SHOW DATABASES LIKE ?"
   query_args = ["my_app_db"]
 }
-`, testWorkspaceEndpoint, password)
+`, testutil.TestWorkspaceEndpoint, password)
 	}
 
 	testutil.UnitTest(t, testutil.UnitTestConfig{
-		APIKey: testutil.UnusedAPIKey,
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
 	}, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
@@ -667,8 +644,406 @@ func TestDataAPIRequestBodyShape(t *testing.T) {
 	require.Equal(t, "db", captured["database"])
 }
 
+func statementListsConfig(execute, revert string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_execute" "this" {
+  endpoint = %q
+  username = "admin"
+  password = "secret"
+  database = "app"
+  execute  = %s
+  revert   = %s
+}
+`, testutil.TestWorkspaceEndpoint, execute, revert)
+}
+
+// recordedStatements records the executed statements of a mock workspace and fails the statements in fail.
+type recordedStatements struct {
+	*testutil.FakeWorkspace
+	fail map[string]bool
+}
+
+func newRecordedStatements(t *testing.T, fail ...string) *recordedStatements {
+	t.Helper()
+
+	w := &recordedStatements{fail: map[string]bool{}}
+	for _, statement := range fail {
+		w.fail[statement] = true
+	}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.DataAPIHandler{
+		Exec: func(req testutil.DataAPIRequest) error {
+			w.Record(req.SQL)
+			if w.fail[req.SQL] {
+				return fmt.Errorf("cannot run %s", req.SQL)
+			}
+
+			return nil
+		},
+	})
+
+	return w
+}
+
+func (w *recordedStatements) succeed(statement string) {
+	w.Do(func() {
+		delete(w.fail, statement)
+	})
+}
+
+func TestSQLExecuteStatementLists(t *testing.T) {
+	w := newRecordedStatements(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: statementListsConfig(
+					`["CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)", "CREATE TABLE c (id INT)"]`,
+					`["DROP TABLE a", "DROP TABLE b", "DROP TABLE c"]`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "executed_statements", "3"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "execute.#", "3"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "revert.2", "DROP TABLE c"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE a (id INT)",
+		"CREATE TABLE b (id INT)",
+		"CREATE TABLE c (id INT)",
+		"DROP TABLE c",
+		"DROP TABLE b",
+		"DROP TABLE a",
+	}, w.Executed())
+}
+
+func TestSQLExecutePartialFailureRevertsSucceededStatements(t *testing.T) {
+	w := newRecordedStatements(t, "CREATE TABLE b (id INT)")
+
+	config := statementListsConfig(
+		`["CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)", "CREATE TABLE c (id INT)"]`,
+		`["DROP TABLE a", "DROP TABLE b", "DROP TABLE c"]`,
+	)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Statement 2 of 3 failed`),
+			},
+			{
+				// The tainted resource reverts only the first statement before it is created again.
+				PreConfig: func() {
+					w.succeed("CREATE TABLE b (id INT)")
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "executed_statements", "3"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE a (id INT)",
+		"CREATE TABLE b (id INT)",
+		"DROP TABLE a",
+		"CREATE TABLE a (id INT)",
+		"CREATE TABLE b (id INT)",
+		"CREATE TABLE c (id INT)",
+		"DROP TABLE c",
+		"DROP TABLE b",
+		"DROP TABLE a",
+	}, w.Executed())
+}
+
+func TestSQLExecuteStatementListsFailedRevertResumes(t *testing.T) {
+	w := newRecordedStatements(t, "DROP TABLE b")
+
+	config := statementListsConfig(
+		`["CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)", "CREATE TABLE c (id INT)"]`,
+		`["DROP TABLE a", "DROP TABLE b", "DROP TABLE c"]`,
+	)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Revert statement 2 of 3 failed`),
+			},
+			{
+				PreConfig: func() {
+					w.succeed("DROP TABLE b")
+				},
+				Config:  config,
+				Destroy: true,
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE a (id INT)",
+		"CREATE TABLE b (id INT)",
+		"CREATE TABLE c (id INT)",
+		"DROP TABLE c",
+		"DROP TABLE b",
+		"DROP TABLE b",
+		"DROP TABLE a",
+	}, w.Executed())
+}
+
+func TestSQLExecuteStatementListsValidation(t *testing.T) {
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIKey: testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      statementListsConfig(`["CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)"]`, `["DROP TABLE a"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("revert lists 1 statements but execute lists 2"),
+			},
+			{
+				Config:      statementListsConfig(`[]`, `"SELECT 1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("At least one statement is required"),
+			},
+			{
+				Config:      statementListsConfig(`{ sql = "SELECT 1" }`, `"SELECT 1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a string or a list of strings"),
+			},
+		},
+	})
+}
+
+func expectedResultsConfig() string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_execute" "this" {
+  endpoint         = %q
+  username         = "admin"
+  password         = "secret"
+  database         = "app"
+  execute          = "CREATE TABLE t (id INT)"
+  revert           = "DROP TABLE IF EXISTS t"
+  query            = "SHOW TABLES LIKE 't'"
+  expected_results = [{ Tables_in_app = "t" }]
+}
+`, testutil.TestWorkspaceEndpoint)
+}
+
+func TestSQLExecuteExpectedResultsReplacesOnDrift(t *testing.T) {
+	var mu sync.Mutex
+	var statements []string
+	exists := false
+
+	dataAPI := testutil.MockDataAPIServer(t, testutil.DataAPIHandler{
+		Exec: func(req testutil.DataAPIRequest) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			statements = append(statements, req.SQL)
+			exists = strings.HasPrefix(req.SQL, "CREATE")
+
+			return nil
+		},
+		Query: func(_ testutil.DataAPIRequest) ([]map[string]any, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if !exists {
+				return nil, nil
+			}
+
+			return []map[string]any{{"Tables_in_app": "t"}}, nil
+		},
+	})
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: expectedResultsConfig(),
+				Check:  resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "query_results.0.Tables_in_app", "t"),
+			},
+			{
+				// The table is dropped outside of Terraform.
+				PreConfig: func() {
+					mu.Lock()
+					defer mu.Unlock()
+
+					exists = false
+				},
+				Config: expectedResultsConfig(),
+				Check:  resource.TestCheckResourceAttr("singlestoredb_sql_execute.this", "query_results.#", "1"),
+			},
+		},
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Equal(t, []string{
+		"CREATE TABLE t (id INT)",
+		"DROP TABLE IF EXISTS t",
+		"CREATE TABLE t (id INT)",
+		"DROP TABLE IF EXISTS t",
+	}, statements)
+}
+
+func TestSQLExecuteExpectedResultsRequiresQuery(t *testing.T) {
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIKey: testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_execute" "this" {
+  endpoint         = %q
+  username         = "admin"
+  password         = "secret"
+  execute          = "CREATE TABLE t (id INT)"
+  revert           = "DROP TABLE t"
+  expected_results = [{ id = "1" }]
+}
+`, testutil.TestWorkspaceEndpoint),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected_results requires query"),
+			},
+		},
+	})
+}
+
+func TestSQLExecuteValidateOnPlan(t *testing.T) {
+	dataAPI := testutil.MockDataAPIServer(t, testutil.DataAPIHandler{
+		Exec: func(req testutil.DataAPIRequest) error {
+			return fmt.Errorf("unexpected statement %s during plan", req.SQL)
+		},
+		Query: func(req testutil.DataAPIRequest) ([]map[string]any, error) {
+			if strings.Contains(req.SQL, "missing") {
+				return nil, fmt.Errorf("Table 'app.missing' doesn't exist")
+			}
+
+			return nil, nil
+		},
+	})
+
+	validateOnPlanConfig := func(execute string) string {
+		return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_execute" "this" {
+  endpoint         = %q
+  username         = "admin"
+  password         = "secret"
+  database         = "app"
+  execute          = %s
+  revert           = "DELETE FROM t"
+  validate_on_plan = true
+}
+`, testutil.TestWorkspaceEndpoint, execute)
+	}
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		DataAPIHTTPClient: dataAPI,
+		APIKey:            testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      validateOnPlanConfig(`"INSERT INTO missing VALUES (1)"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid SQL in execute"),
+			},
+			{
+				// The INSERT depends on the table created before it, so it is not validated.
+				Config:             validateOnPlanConfig(`["CREATE TABLE missing (id INT)", "INSERT INTO missing VALUES (1)"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestSQLExecuteUpgradeStateFromSingleStatements(t *testing.T) {
+	ctx := t.Context()
+
+	r, ok := sql.NewResource().(fwresource.ResourceWithUpgradeState)
+	require.True(t, ok)
+
+	upgrader := r.UpgradeState(ctx)[0]
+
+	type modelV0 struct {
+		ID           types.String `tfsdk:"id"`
+		Endpoint     types.String `tfsdk:"endpoint"`
+		Username     types.String `tfsdk:"username"`
+		Password     types.String `tfsdk:"password"`
+		Database     types.String `tfsdk:"database"`
+		Execute      types.String `tfsdk:"execute"`
+		ExecuteArgs  types.List   `tfsdk:"execute_args"`
+		Revert       types.String `tfsdk:"revert"`
+		Query        types.String `tfsdk:"query"`
+		QueryArgs    types.List   `tfsdk:"query_args"`
+		QueryResults types.List   `tfsdk:"query_results"`
+		LastInsertID types.Int64  `tfsdk:"last_insert_id"`
+		RowsAffected types.Int64  `tfsdk:"rows_affected"`
+	}
+
+	prior := tfsdk.State{Schema: *upgrader.PriorSchema, Raw: tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil)}
+	require.False(t, prior.Set(ctx, modelV0{
+		ID:           types.StringValue("id"),
+		Endpoint:     types.StringValue(testutil.TestWorkspaceEndpoint),
+		Username:     types.StringValue("admin"),
+		Password:     types.StringNull(),
+		Database:     types.StringValue("app"),
+		Execute:      types.StringValue("CREATE TABLE a (id INT)"),
+		ExecuteArgs:  types.ListNull(types.StringType),
+		Revert:       types.StringValue("DROP TABLE a"),
+		Query:        types.StringNull(),
+		QueryArgs:    types.ListNull(types.StringType),
+		QueryResults: sql.EmptyQueryResults(),
+		LastInsertID: types.Int64Value(0),
+		RowsAffected: types.Int64Value(1),
+	}).HasError())
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	resp := fwresource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{State: &prior}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var execute, revert types.Dynamic
+	var executed types.Int64
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("execute"), &execute).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("revert"), &revert).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("executed_statements"), &executed).HasError())
+	require.Equal(t, types.DynamicValue(types.StringValue("CREATE TABLE a (id INT)")), execute)
+	require.Equal(t, types.DynamicValue(types.StringValue("DROP TABLE a")), revert)
+	require.Equal(t, int64(1), executed.ValueInt64())
+}
+
 func TestSQLExecuteResourceIntegration(t *testing.T) {
-	adminPassword := testAdminPassword
+	adminPassword := testutil.TestAdminPassword
 	isDataAPIReady := testutil.IsDataAPIReady(adminPassword)
 
 	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
@@ -692,7 +1067,7 @@ func TestSQLExecuteResourceIntegration(t *testing.T) {
 }
 
 func TestWorkspaceWithSQLResourceIntegration(t *testing.T) {
-	adminPassword := testAdminPassword
+	adminPassword := testutil.TestAdminPassword
 	isDataAPIReady := testutil.IsDataAPIReady(adminPassword)
 
 	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
@@ -715,8 +1090,7 @@ func TestWorkspaceWithSQLResourceIntegration(t *testing.T) {
 					resource.TestCheckResourceAttrSet("singlestoredb_sql_execute.create_readonly_user", config.IDAttribute),
 					resource.TestCheckResourceAttrSet("singlestoredb_sql_execute.grant_app_user", config.IDAttribute),
 					resource.TestCheckResourceAttrSet("singlestoredb_sql_execute.grant_readonly", config.IDAttribute),
-					resource.TestCheckResourceAttrSet("singlestoredb_sql_execute.create_users_table", config.IDAttribute),
-					resource.TestCheckResourceAttrSet("singlestoredb_sql_execute.create_posts_table", config.IDAttribute),
+					resource.TestCheckResourceAttr("singlestoredb_sql_execute.create_tables", "executed_statements", "2"),
 					resource.TestCheckResourceAttrSet("singlestoredb_sql_execute.attach_app_db_readonly", config.IDAttribute),
 					resource.TestCheckResourceAttr("singlestoredb_sql_execute.attach_app_db_readonly", "query_results.#", "1"),
 				),
@@ -726,7 +1100,7 @@ func TestWorkspaceWithSQLResourceIntegration(t *testing.T) {
 }
 
 func TestSQLExecuteDriftIntegration(t *testing.T) {
-	adminPassword := testAdminPassword
+	adminPassword := testutil.TestAdminPassword
 	isDataAPIReady := testutil.IsDataAPIReady(adminPassword)
 
 	var workspaceEndpoint string
@@ -0,0 +1,158 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	autoResumeDescription = "Resume the workspace `workspace_id` through the Management API before running SQL when it is suspended, " +
+		"and wait for its Data API to accept queries. Defaults to `false`, with which statements against a suspended workspace fail as unreachable."
+	workspaceIDDescription = "ID of the workspace of `endpoint`, typically `singlestoredb_workspace.<n>.id`. Required when `auto_resume` is `true`."
+)
+
+// AutoResume resumes the workspace of the SQL statements when it is suspended.
+type AutoResume struct {
+	// Client is the Management API client of the provider.
+	Client management.ClientWithResponsesInterface
+	// Enabled is the auto_resume attribute.
+	Enabled types.Bool
+	// WorkspaceID is the workspace_id attribute.
+	WorkspaceID types.String
+}
+
+// ValidateAutoResume reports a missing workspace_id when auto_resume is true.
+func ValidateAutoResume(enabled types.Bool, workspaceID types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if enabled.ValueBool() && workspaceID.IsNull() {
+		diags.AddAttributeError(
+			path.Root("workspace_id"),
+			"Invalid configuration",
+			"auto_resume requires workspace_id, the ID of the workspace of endpoint.",
+		)
+	}
+
+	return diags
+}
+
+// Resume makes sure that the workspace is active and its Data API accepts queries before the statements run.
+// Active workspaces cost a single Management API call. Workspaces that no longer exist are left alone,
+// so that the statements fail as unreachable, which destroy tolerates.
+func (a AutoResume) Resume(ctx context.Context, client *Client, endpoint string) *util.SummaryWithDetailError {
+	if !a.Enabled.ValueBool() {
+		return nil
+	}
+
+	if a.Client == nil {
+		return &util.SummaryWithDetailError{
+			Summary: "Cannot resume the workspace",
+			Detail:  "auto_resume requires the provider to be configured with a SingleStore API key.",
+		}
+	}
+
+	id, err := uuid.Parse(a.WorkspaceID.ValueString())
+	if err != nil {
+		return &util.SummaryWithDetailError{
+			Summary: "Invalid workspace ID",
+			Detail:  fmt.Sprintf("auto_resume requires workspace_id to be a valid UUID, got %q: %s", a.WorkspaceID.ValueString(), err),
+		}
+	}
+
+	workspace, err := a.Client.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err, util.ReturnNilOnNotFound); serr != nil {
+		return serr
+	}
+
+	if workspace.JSON200 == nil || workspace.JSON200.State == management.WorkspaceStateACTIVE ||
+		workspace.JSON200.State == management.WorkspaceStateTERMINATED {
+		return nil
+	}
+
+	if serr := a.resume(ctx, id, workspace.JSON200.WorkspaceGroupID.String()); serr != nil {
+		return serr
+	}
+
+	return waitForDataAPI(ctx, client, endpoint, config.DataAPIReadyTimeout)
+}
+
+// resume resumes the workspace if it is suspended and waits until it is active, serialized with the other
+// operations in its workspace group. The state is read again under the lock, since the SQL resources
+// of the same workspace resume it concurrently, and only the first one should send the resume request.
+func (a AutoResume) resume(ctx context.Context, id management.WorkspaceID, workspaceGroupID string) *util.SummaryWithDetailError {
+	unlock, lerr := util.LockWorkspaceGroup(ctx, workspaceGroupID, fmt.Sprintf("Resuming the workspace %s", id))
+	if lerr != nil {
+		return lerr
+	}
+	defer unlock()
+
+	workspace, err := a.Client.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+	if serr := util.StatusOK(workspace, err); serr != nil {
+		return serr
+	}
+
+	if workspace.JSON200 != nil && workspace.JSON200.State == management.WorkspaceStateSUSPENDED {
+		resumeResponse, err := a.Client.PostV1WorkspacesWorkspaceIDResumeWithResponse(ctx, id, management.WorkspaceResume{})
+		if serr := util.StatusOK(resumeResponse, err); serr != nil {
+			return serr
+		}
+	}
+
+	return waitForActiveWorkspace(ctx, a.Client, id)
+}
+
+func waitForActiveWorkspace(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceID) *util.SummaryWithDetailError {
+	_, err := util.Poller[management.Workspace]{
+		Description:       fmt.Sprintf("workspace %s", id),
+		Timeout:           config.WorkspaceResumeTimeout,
+		ConsistencyWindow: config.WorkspaceConsistencyThreshold,
+		Get: func(ctx context.Context) (management.Workspace, error) {
+			workspace, err := c.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+			if err != nil {
+				return management.Workspace{}, util.StopPolling(fmt.Errorf("failed to get workspace %s: %w", id, err))
+			}
+
+			if code := workspace.StatusCode(); code != http.StatusOK {
+				return management.Workspace{}, fmt.Errorf("failed to get workspace %s: status code %s", id, http.StatusText(code))
+			}
+
+			return *workspace.JSON200, nil
+		},
+		Terminal: func(w management.Workspace) *util.TerminalStateError {
+			if w.State != management.WorkspaceStateFAILED && w.State != management.WorkspaceStateTERMINATED {
+				return nil
+			}
+
+			return &util.TerminalStateError{
+				State: string(w.State),
+				Err:   errors.New("the workspace cannot be resumed"),
+			}
+		},
+		Conditions: []util.PollCondition[management.Workspace]{
+			func(w management.Workspace) error {
+				if w.State != management.WorkspaceStateACTIVE {
+					return fmt.Errorf("workspace %s state is %s, want %s", id, w.State, management.WorkspaceStateACTIVE)
+				}
+
+				return nil
+			},
+		},
+	}.Poll(ctx)
+	if err != nil {
+		return &util.SummaryWithDetailError{
+			Summary: fmt.Sprintf("Failed to resume the workspace %s", id),
+			Detail:  fmt.Sprintf("Workspace is not active: %s", err.Error()),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package sql_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWorkspaces answers the workspace calls of auto_resume. Other calls panic through the nil interface.
+type fakeWorkspaces struct {
+	management.ClientWithResponsesInterface
+
+	mu      sync.Mutex
+	state   management.WorkspaceState
+	status  int
+	gets    atomic.Int32
+	resumes atomic.Int32
+}
+
+func (f *fakeWorkspaces) GetV1WorkspacesWorkspaceIDWithResponse(_ context.Context, id management.WorkspaceID, _ *management.GetV1WorkspacesWorkspaceIDParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspacesWorkspaceIDResponse, error) {
+	f.gets.Add(1)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	resp := &management.GetV1WorkspacesWorkspaceIDResponse{HTTPResponse: &http.Response{StatusCode: f.status}}
+	if f.status == http.StatusOK {
+		resp.JSON200 = &management.Workspace{WorkspaceID: id, State: f.state}
+	}
+
+	return resp, nil
+}
+
+func (f *fakeWorkspaces) PostV1WorkspacesWorkspaceIDResumeWithResponse(_ context.Context, _ management.WorkspaceID, _ management.WorkspaceResume, _ ...management.RequestEditorFn) (*management.PostV1WorkspacesWorkspaceIDResumeResponse, error) {
+	f.resumes.Add(1)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.state = management.WorkspaceStateACTIVE
+
+	return &management.PostV1WorkspacesWorkspaceIDResumeResponse{HTTPResponse: &http.Response{StatusCode: http.StatusOK}}, nil
+}
+
+func autoResume(c management.ClientWithResponsesInterface) sql.AutoResume {
+	return sql.AutoResume{
+		Client:      c,
+		Enabled:     types.BoolValue(true),
+		WorkspaceID: types.StringValue(uuid.NewString()),
+	}
+}
+
+func TestAutoResumeResumesSuspendedWorkspace(t *testing.T) {
+	var queryCalls atomic.Int32
+	dataAPI := testutil.MockDataAPIServer(t, notReadyThenReadyHandler(t, 1, &queryCalls))
+
+	workspaces := &fakeWorkspaces{state: management.WorkspaceStateSUSPENDED, status: http.StatusOK}
+	client := sql.NewClientForTest(dataAPI(), "https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+
+	serr := autoResume(workspaces).Resume(t.Context(), client, testutil.TestWorkspaceEndpoint)
+	require.Nil(t, serr)
+	require.Equal(t, int32(1), workspaces.resumes.Load())
+	require.Positive(t, queryCalls.Load(), "the Data API should be awaited after resuming")
+}
+
+func TestAutoResumeResumesOnce(t *testing.T) {
+	var queryCalls atomic.Int32
+	dataAPI := testutil.MockDataAPIServer(t, notReadyThenReadyHandler(t, 0, &queryCalls))
+
+	workspaces := &fakeWorkspaces{state: management.WorkspaceStateSUSPENDED, status: http.StatusOK}
+	a := autoResume(workspaces)
+
+	// The SQL resources of a workspace resume it concurrently.
+	var wg sync.WaitGroup
+	serrs := make([]*util.SummaryWithDetailError, 3)
+	for i := range serrs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			client := sql.NewClientForTest(dataAPI(), "https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+			serrs[i] = a.Resume(t.Context(), client, testutil.TestWorkspaceEndpoint)
+		}()
+	}
+	wg.Wait()
+
+	for _, serr := range serrs {
+		require.Nil(t, serr)
+	}
+	require.Equal(t, int32(1), workspaces.resumes.Load())
+}
+
+func TestAutoResumeInvalidWorkspaceID(t *testing.T) {
+	a := autoResume(&fakeWorkspaces{})
+	a.WorkspaceID = types.StringValue("not-a-uuid")
+
+	serr := a.Resume(t.Context(), sql.NewClient("https://"+testutil.TestWorkspaceEndpoint, "admin", "secret"), testutil.TestWorkspaceEndpoint)
+	require.NotNil(t, serr)
+	require.Equal(t, "Invalid workspace ID", serr.Summary)
+}
+
+func TestAutoResumeSkipsActiveWorkspace(t *testing.T) {
+	workspaces := &fakeWorkspaces{state: management.WorkspaceStateACTIVE, status: http.StatusOK}
+	client := sql.NewClient("https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+
+	serr := autoResume(workspaces).Resume(t.Context(), client, testutil.TestWorkspaceEndpoint)
+	require.Nil(t, serr)
+	require.Equal(t, int32(1), workspaces.gets.Load())
+	require.Zero(t, workspaces.resumes.Load())
+}
+
+func TestAutoResumeSkipsMissingWorkspace(t *testing.T) {
+	workspaces := &fakeWorkspaces{status: http.StatusNotFound}
+	client := sql.NewClient("https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+
+	serr := autoResume(workspaces).Resume(t.Context(), client, testutil.TestWorkspaceEndpoint)
+	require.Nil(t, serr)
+	require.Zero(t, workspaces.resumes.Load())
+}
+
+func TestAutoResumeDisabled(t *testing.T) {
+	a := autoResume(nil)
+	a.Enabled = types.BoolNull()
+
+	require.Nil(t, a.Resume(t.Context(), sql.NewClient("https://"+testutil.TestWorkspaceEndpoint, "admin", "secret"), testutil.TestWorkspaceEndpoint))
+}
+
+func TestAutoResumeWithoutManagementClient(t *testing.T) {
+	serr := autoResume(nil).Resume(t.Context(), sql.NewClient("https://"+testutil.TestWorkspaceEndpoint, "admin", "secret"), testutil.TestWorkspaceEndpoint)
+	require.NotNil(t, serr)
+	require.Contains(t, serr.Detail, "API key")
+}
+
+func TestValidateAutoResume(t *testing.T) {
+	require.False(t, sql.ValidateAutoResume(types.BoolNull(), types.StringNull()).HasError())
+	require.False(t, sql.ValidateAutoResume(types.BoolValue(true), types.StringValue(uuid.NewString())).HasError())
+	require.True(t, sql.ValidateAutoResume(types.BoolValue(true), types.StringNull()).HasError())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -95,6 +96,15 @@ func EmptyQueryResults() types.List {
 	return types.ListValueMust(QueryResultsElementType, []attr.Value{})
 }
 
+// allResultSets returns all the result sets of resp in order.
+func allResultSets(resp *QueryRowsResponse) []QueryResultSet {
+	if resp == nil {
+		return nil
+	}
+
+	return resp.Results
+}
+
 // firstResultSetRows returns results[0].rows or nil when empty.
 func firstResultSetRows(resp *QueryRowsResponse) []map[string]any {
 	if resp == nil || len(resp.Results) == 0 {
@@ -104,6 +114,16 @@ func firstResultSetRows(resp *QueryRowsResponse) []map[string]any {
 	return resp.Results[0].Rows
 }
 
+// IsTrue reports whether a stringified boolean column, e.g., IS_SYNC, is set.
+func IsTrue(value string) bool {
+	switch strings.ToLower(value) {
+	case "1", "true", "yes", "on":
+		return true
+	default:
+		return false
+	}
+}
+
 // StringArgsToAny converts Terraform list(string) args to Data API []any args.
 func StringArgsToAny(in []string) []any {
 	out := make([]any, len(in))
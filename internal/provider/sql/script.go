@@ -0,0 +1,170 @@
+package sql
+
+import (
+	"strings"
+	"unicode"
+)
+
+// blockEnds are the words after END that close a statement of a procedural block, e.g., END IF,
+// as opposed to a plain END that closes BEGIN or CASE.
+var blockEnds = []string{"IF", "LOOP", "WHILE", "REPEAT", "FOR"}
+
+// routineKeywords mark the CREATE statements of routines, whose DECLARE section before BEGIN
+// contains semicolons as well.
+var routineKeywords = []string{"PROCEDURE", "FUNCTION", "AGGREGATE"}
+
+// SplitStatements splits a script into statements at the semicolons that are outside of string literals,
+// quoted identifiers, comments, and BEGIN ... END blocks, so that a migration file with several statements
+// runs one statement per Data API request. Statements that contain only comments are dropped,
+// and the statements are trimmed; comments before a statement are kept with it.
+func SplitStatements(script string) []string {
+	var (
+		result []string
+		start  int
+		depth  int
+		// The state of the current statement.
+		words   []string
+		routine bool
+		blocks  bool
+	)
+
+	flush := func(end int) {
+		if !onlyComments(script[start:end]) {
+			result = append(result, strings.TrimSpace(script[start:end]))
+		}
+
+		words, routine, blocks = nil, false, false
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(script, i)
+		case c == '-' && strings.HasPrefix(script[i:], "-- "):
+			i = skipLineComment(script, i)
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			i = skipBlockComment(script, i)
+		case isWordStart(script, i):
+			word, next := readWord(script, i)
+			word = strings.ToUpper(word)
+			words = append(words, word)
+
+			if len(words) <= 6 && words[0] == "CREATE" && isOneOf(word, routineKeywords) {
+				routine = true
+			}
+
+			switch word {
+			case "BEGIN":
+				// BEGIN; and BEGIN WORK start a transaction rather than a block.
+				rest := skipSpace(script, next)
+				after, _ := readWord(script, rest)
+				if (rest >= len(script) || script[rest] != ';') && !strings.EqualFold(after, "WORK") {
+					depth++
+					blocks = true
+				}
+			case "CASE":
+				depth++
+			case "END":
+				after, _ := readWord(script, skipSpace(script, next))
+				if depth > 0 && !isOneOf(strings.ToUpper(after), blockEnds) {
+					depth--
+				}
+			}
+
+			i = next - 1
+		case c == ';' && depth == 0 && (!routine || blocks):
+			flush(i)
+			start = i + 1
+		}
+	}
+
+	flush(len(script))
+
+	return result
+}
+
+// onlyComments reports whether the text contains nothing but comments and whitespace.
+func onlyComments(text string) bool {
+	for i := 0; i < len(text); i++ {
+		switch {
+		case strings.HasPrefix(text[i:], "-- "):
+			i = skipLineComment(text, i)
+		case strings.HasPrefix(text[i:], "/*"):
+			i = skipBlockComment(text, i)
+		case !unicode.IsSpace(rune(text[i])):
+			return false
+		}
+	}
+
+	return true
+}
+
+// skipLineComment returns the index of the newline that ends the comment starting at i.
+func skipLineComment(script string, i int) int {
+	if end := strings.IndexByte(script[i:], '\n'); end >= 0 {
+		return i + end
+	}
+
+	return len(script)
+}
+
+// skipBlockComment returns the index of the slash that ends the comment starting at i.
+func skipBlockComment(script string, i int) int {
+	if end := strings.Index(script[i+2:], "*/"); end >= 0 {
+		return i + end + 3
+	}
+
+	return len(script)
+}
+
+// skipQuoted returns the index of the quote that closes the literal or identifier starting at i.
+func skipQuoted(script string, i int) int {
+	quote := script[i]
+	for j := i + 1; j < len(script); j++ {
+		switch {
+		case script[j] == '\\' && quote != '`':
+			j++
+		case script[j] == quote:
+			return j
+		}
+	}
+
+	return len(script)
+}
+
+func isWordStart(script string, i int) bool {
+	return isWordByte(script[i]) && (i == 0 || !isWordByte(script[i-1]))
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == '$' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+func readWord(script string, i int) (string, int) {
+	end := i
+	for end < len(script) && isWordByte(script[end]) {
+		end++
+	}
+
+	return script[i:end], end
+}
+
+func skipSpace(script string, i int) int {
+	for i < len(script) && unicode.IsSpace(rune(script[i])) {
+		i++
+	}
+
+	return i
+}
+
+func isOneOf(word string, words []string) bool {
+	for _, w := range words {
+		if word == w {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	require.Empty(t, sql.SplitStatements(" ;\n-- Nothing to do.\n"))
+	require.Equal(t, []string{"SELECT 1"}, sql.SplitStatements("SELECT 1"))
+	require.Equal(t,
+		[]string{
+			"CREATE TABLE t (a INT, b TEXT)",
+			"-- A comment; with a semicolon.\nINSERT INTO t VALUES (1, 'a;b'), (2, \"it\\\"s;\")",
+			"/* Another; comment */\nALTER TABLE `semi;colon` ADD COLUMN c INT",
+		},
+		sql.SplitStatements("CREATE TABLE t (a INT, b TEXT);\n"+
+			"-- A comment; with a semicolon.\n"+
+			"INSERT INTO t VALUES (1, 'a;b'), (2, \"it\\\"s;\");\n"+
+			"/* Another; comment */\n"+
+			"ALTER TABLE `semi;colon` ADD COLUMN c INT;\n"),
+	)
+}
+
+func TestSplitStatementsBlocks(t *testing.T) {
+	procedure := "CREATE PROCEDURE p(n INT) AS\n" +
+		"DECLARE total INT = 0;\n" +
+		"BEGIN\n" +
+		"  IF n > 0 THEN\n" +
+		"    total = n;\n" +
+		"  END IF;\n" +
+		"  WHILE total > 0 LOOP\n" +
+		"    total = total - 1;\n" +
+		"  END LOOP;\n" +
+		"  SELECT CASE WHEN total = 0 THEN 'done' ELSE 'not done' END;\n" +
+		"END"
+	require.Equal(t,
+		[]string{"CREATE TABLE t (a INT)", procedure, "CALL p(3)"},
+		sql.SplitStatements("CREATE TABLE t (a INT);\n"+procedure+";\nCALL p(3);"),
+	)
+
+	require.Equal(t,
+		[]string{"BEGIN", "INSERT INTO t VALUES (1)", "COMMIT"},
+		sql.SplitStatements("BEGIN;\nINSERT INTO t VALUES (1);\nCOMMIT;"),
+		"a transaction is not a block",
+	)
+}
@@ -0,0 +1,243 @@
+package sql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// rowBudget counts the rows of a query response against the QueryLimits.
+type rowBudget struct {
+	limits QueryLimits
+	rows   int
+	bytes  int64
+}
+
+// take accounts for a row of the given size and reports whether it is within the limits.
+func (b *rowBudget) take(size int64) bool {
+	if b.limits.MaxRows > 0 && b.rows >= b.limits.MaxRows {
+		return false
+	}
+
+	if b.limits.MaxBytes > 0 && b.bytes+size > b.limits.MaxBytes {
+		return false
+	}
+
+	b.rows++
+	b.bytes += size
+
+	return true
+}
+
+// decodeTuples decodes a /api/v2/query/tuples response while it is read, e.g.,
+// {"results":[{"columns":[...],"rows":[["a",1],...]}]}. Rows beyond the limits are skipped
+// token by token, so they are not held in memory, while an error after the results is still decoded.
+func decodeTuples(r io.Reader, limits QueryLimits) (*QueryRowsResponse, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	result := &QueryRowsResponse{}
+	budget := &rowBudget{limits: limits}
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	for dec.More() {
+		key, err := objectKey(dec)
+		if err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "results":
+			if err := decodeResultSets(dec, result, budget); err != nil {
+				return nil, err
+			}
+
+			// A truncated result leaves the rows, the result set, and the results open.
+			if result.Truncated {
+				if err := skipOpenValues(dec, 3); err != nil {
+					return nil, err
+				}
+			}
+		case "error":
+			if err := dec.Decode(&result.Error); err != nil {
+				return nil, err
+			}
+		default:
+			if err := skipValue(dec); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func decodeResultSets(dec *json.Decoder, result *QueryRowsResponse, budget *rowBudget) error {
+	isNull, err := openArrayOrNull(dec)
+	if err != nil || isNull {
+		return err
+	}
+
+	for dec.More() {
+		set, truncated, err := decodeResultSet(dec, budget)
+		if err != nil {
+			return err
+		}
+
+		result.Results = append(result.Results, set)
+
+		if truncated {
+			result.Truncated = true
+
+			return nil
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func decodeResultSet(dec *json.Decoder, budget *rowBudget) (QueryResultSet, bool, error) {
+	set := QueryResultSet{Rows: []map[string]any{}}
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return QueryResultSet{}, false, err
+	}
+
+	for dec.More() {
+		key, err := objectKey(dec)
+		if err != nil {
+			return QueryResultSet{}, false, err
+		}
+
+		switch key {
+		case "columns":
+			if err := dec.Decode(&set.Columns); err != nil {
+				return QueryResultSet{}, false, err
+			}
+		case "rows":
+			truncated, err := decodeRows(dec, &set, budget)
+			if err != nil || truncated {
+				return set, truncated, err
+			}
+		default:
+			if err := skipValue(dec); err != nil {
+				return QueryResultSet{}, false, err
+			}
+		}
+	}
+
+	return set, false, expectDelim(dec, '}')
+}
+
+// decodeRows decodes the tuples of a result set into maps keyed by column name.
+// It reports whether a row was dropped because of the limits.
+func decodeRows(dec *json.Decoder, set *QueryResultSet, budget *rowBudget) (bool, error) {
+	isNull, err := openArrayOrNull(dec)
+	if err != nil || isNull {
+		return false, err
+	}
+
+	for dec.More() {
+		if set.Columns == nil {
+			return false, errors.New("rows before columns in result set")
+		}
+
+		start := dec.InputOffset()
+
+		var tuple []any
+		if err := dec.Decode(&tuple); err != nil {
+			return false, err
+		}
+
+		if !budget.take(dec.InputOffset() - start) {
+			return true, nil
+		}
+
+		if len(tuple) != len(set.Columns) {
+			return false, fmt.Errorf("row has %d values for %d columns", len(tuple), len(set.Columns))
+		}
+
+		row := make(map[string]any, len(tuple))
+		for i, value := range tuple {
+			row[set.Columns[i].Name] = value
+		}
+
+		set.Rows = append(set.Rows, row)
+	}
+
+	return false, expectDelim(dec, ']')
+}
+
+func objectKey(dec *json.Decoder) (string, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	key, ok := token.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected %v, want an object key", token)
+	}
+
+	return key, nil
+}
+
+// openArrayOrNull reads the start of an array and reports whether the value is null instead.
+func openArrayOrNull(dec *json.Decoder) (bool, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return false, err
+	}
+
+	if token == nil {
+		return true, nil
+	}
+
+	if token != json.Delim('[') {
+		return false, fmt.Errorf("unexpected %v, want an array", token)
+	}
+
+	return false, nil
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("unexpected %v, want %v", token, delim)
+	}
+
+	return nil
+}
+
+func skipValue(dec *json.Decoder) error {
+	var value json.RawMessage
+
+	return dec.Decode(&value)
+}
+
+// skipOpenValues reads the rest of the given number of nested arrays and objects that are open.
+func skipOpenValues(dec *json.Decoder, depth int) error {
+	for depth > 0 {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token {
+		case json.Delim('['), json.Delim('{'):
+			depth++
+		case json.Delim(']'), json.Delim('}'):
+			depth--
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// ColumnsElementType is the Terraform type for the columns of a result set.
+var ColumnsElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":     types.StringType,
+		"type":     types.StringType,
+		"nullable": types.BoolType,
+	},
+}
+
+// numericDataTypes are the SQL types whose values are Terraform numbers, even when
+// the Data API sends them as strings, e.g., DECIMAL.
+var numericDataTypes = map[string]bool{
+	"TINYINT":   true,
+	"SMALLINT":  true,
+	"MEDIUMINT": true,
+	"INT":       true,
+	"INTEGER":   true,
+	"BIGINT":    true,
+	"DECIMAL":   true,
+	"DEC":       true,
+	"NUMERIC":   true,
+	"FIXED":     true,
+	"FLOAT":     true,
+	"DOUBLE":    true,
+	"REAL":      true,
+}
+
+// queryResult is a result set in the forms of the Terraform attributes.
+type queryResult struct {
+	// Rows are the rows with all values as strings.
+	Rows types.List
+	// TypedRows are the rows as a list of objects that keep numbers, bools, and nulls.
+	TypedRows types.Dynamic
+	// Columns are the names and SQL types of the columns in select-list order.
+	Columns types.List
+	// ResultSets are the rows, typed rows, and columns of every result set in order.
+	ResultSets types.Dynamic
+	// Failed is set when the query failed, and the attributes are empty.
+	Failed bool
+}
+
+// emptyQueryResult returns the result of a query that is unset or failed.
+func emptyQueryResult() queryResult {
+	return queryResult{
+		Rows:       EmptyQueryResults(),
+		TypedRows:  types.DynamicValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{}}, []attr.Value{})),
+		Columns:    types.ListValueMust(ColumnsElementType, []attr.Value{}),
+		ResultSets: types.DynamicValue(types.TupleValueMust([]attr.Type{}, []attr.Value{})),
+	}
+}
+
+// unknownQueryResult returns the planned result of a changed query.
+func unknownQueryResult() queryResult {
+	return queryResult{
+		Rows:       types.ListUnknown(QueryResultsElementType),
+		TypedRows:  types.DynamicUnknown(),
+		Columns:    types.ListUnknown(ColumnsElementType),
+		ResultSets: types.DynamicUnknown(),
+	}
+}
+
+// newQueryResult converts a query response to the Terraform attributes. Rows, TypedRows, and
+// Columns come from the first result set, and ResultSets has all of them.
+func newQueryResult(ctx context.Context, resp *QueryRowsResponse) (queryResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result := emptyQueryResult()
+	elementTypes := []attr.Type{}
+	elements := []attr.Value{}
+	for i, set := range allResultSets(resp) {
+		setResult, setDiags := newResultSet(ctx, set)
+		diags.Append(setDiags...)
+		if diags.HasError() {
+			return emptyQueryResult(), diags
+		}
+
+		if i == 0 {
+			result = setResult
+		}
+
+		element, elementDiags := resultSetObject(ctx, setResult)
+		diags.Append(elementDiags...)
+		if diags.HasError() {
+			return emptyQueryResult(), diags
+		}
+
+		elementTypes = append(elementTypes, element.Type(ctx))
+		elements = append(elements, element)
+	}
+
+	tuple, tupleDiags := types.TupleValue(elementTypes, elements)
+	diags.Append(tupleDiags...)
+	if diags.HasError() {
+		return emptyQueryResult(), diags
+	}
+
+	result.ResultSets = types.DynamicValue(tuple)
+
+	return result, diags
+}
+
+// resultSetObject converts a result set to an element of result_sets. The typed rows of
+// each result set have their own object type, so result_sets is a tuple rather than a list.
+func resultSetObject(ctx context.Context, set queryResult) (types.Object, diag.Diagnostics) {
+	typedRows := set.TypedRows.UnderlyingValue()
+
+	return types.ObjectValue(map[string]attr.Type{
+		"rows":       set.Rows.Type(ctx),
+		"typed_rows": typedRows.Type(ctx),
+		"columns":    set.Columns.Type(ctx),
+	}, map[string]attr.Value{
+		"rows":       set.Rows,
+		"typed_rows": typedRows,
+		"columns":    set.Columns,
+	})
+}
+
+// newResultSet converts a result set to the Terraform attributes, without ResultSets.
+func newResultSet(ctx context.Context, set QueryResultSet) (queryResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stringified, err := StringifyRows(set.Rows)
+	if err != nil {
+		diags.AddError("Failed to parse query results", err.Error())
+
+		return emptyQueryResult(), diags
+	}
+
+	rows, listDiags := RowsToTFList(ctx, stringified)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return emptyQueryResult(), diags
+	}
+
+	columns := resultColumns(set)
+
+	typedRows, typedDiags := TypedRows(columns, set.Rows)
+	diags.Append(typedDiags...)
+	if diags.HasError() {
+		return emptyQueryResult(), diags
+	}
+
+	return queryResult{
+		Rows:      rows,
+		TypedRows: typedRows,
+		Columns:   ColumnsToTFList(columns),
+	}, diags
+}
+
+// resultColumns returns the columns of a result set. Responses without column metadata
+// fall back to the column names of the first row in alphabetical order, without SQL types.
+func resultColumns(set QueryResultSet) []QueryColumn {
+	if len(set.Columns) > 0 || len(set.Rows) == 0 {
+		return set.Columns
+	}
+
+	return util.Map(slices.Sorted(maps.Keys(set.Rows[0])), func(name string) QueryColumn {
+		return QueryColumn{Name: name, Nullable: true}
+	})
+}
+
+// ColumnsToTFList converts columns to a Terraform list(object({name, type, nullable})).
+// The type is null when the Data API does not report it.
+func ColumnsToTFList(columns []QueryColumn) types.List {
+	elements := util.Map(columns, func(column QueryColumn) attr.Value {
+		dataType := types.StringNull()
+		if column.DataType != "" {
+			dataType = types.StringValue(column.DataType)
+		}
+
+		return types.ObjectValueMust(ColumnsElementType.AttrTypes, map[string]attr.Value{
+			"name":     types.StringValue(column.Name),
+			"type":     dataType,
+			"nullable": types.BoolValue(column.Nullable),
+		})
+	})
+
+	return types.ListValueMust(ColumnsElementType, elements)
+}
+
+// TypedRows converts rows to a Terraform list of objects with an attribute per column.
+// Numeric columns become numbers without losing precision, columns with only boolean
+// values become bools, and all other columns become strings. SQL NULL is a null value.
+func TypedRows(columns []QueryColumn, rows []map[string]any) (types.Dynamic, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrTypes := make(map[string]attr.Type, len(columns))
+	for _, column := range columns {
+		attrTypes[column.Name] = columnType(column, rows)
+	}
+
+	elements := make([]attr.Value, 0, len(rows))
+	for _, row := range rows {
+		values := make(map[string]attr.Value, len(columns))
+		for _, column := range columns {
+			value, err := typedValue(row[column.Name], attrTypes[column.Name])
+			if err != nil {
+				diags.AddError("Failed to parse query results", fmt.Sprintf("column %q: %s", column.Name, err))
+
+				return types.DynamicNull(), diags
+			}
+
+			values[column.Name] = value
+		}
+
+		object, objectDiags := types.ObjectValue(attrTypes, values)
+		diags.Append(objectDiags...)
+		if diags.HasError() {
+			return types.DynamicNull(), diags
+		}
+
+		elements = append(elements, object)
+	}
+
+	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: attrTypes}, elements)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return types.DynamicNull(), diags
+	}
+
+	return types.DynamicValue(list), diags
+}
+
+// columnType returns the Terraform type of a column, so that all rows share the same object type.
+func columnType(column QueryColumn, rows []map[string]any) attr.Type {
+	numeric := isNumericDataType(column.DataType)
+
+	var values, bools, numbers int
+	for _, row := range rows {
+		switch v := row[column.Name].(type) {
+		case nil:
+			continue
+		case bool:
+			bools++
+		case json.Number, float64:
+			numbers++
+		case string:
+			if _, ok := parseNumber(v); ok && numeric {
+				numbers++
+			}
+		}
+
+		values++
+	}
+
+	switch {
+	case values > 0 && bools == values:
+		return types.BoolType
+	case numbers == values && (numeric || values > 0):
+		return types.NumberType
+	default:
+		return types.StringType
+	}
+}
+
+func typedValue(value any, t attr.Type) (attr.Value, error) {
+	switch t {
+	case types.BoolType:
+		if value == nil {
+			return types.BoolNull(), nil
+		}
+
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a boolean", value)
+		}
+
+		return types.BoolValue(b), nil
+	case types.NumberType:
+		if value == nil {
+			return types.NumberNull(), nil
+		}
+
+		number, ok := parseNumber(value)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a number", value)
+		}
+
+		return types.NumberValue(number), nil
+	default:
+		if value == nil {
+			return types.StringNull(), nil
+		}
+
+		s, err := stringifyValue(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.StringValue(s), nil
+	}
+}
+
+// parseNumber parses a JSON number or a numeric string with the precision Terraform uses for numbers.
+func parseNumber(value any) (*big.Float, bool) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	case float64:
+		return big.NewFloat(v), true
+	default:
+		return nil, false
+	}
+
+	number, _, err := big.ParseFloat(strings.TrimSpace(s), 10, 512, big.ToNearestEven)
+	if err != nil {
+		return nil, false
+	}
+
+	return number, true
+}
+
+// isNumericDataType reports whether a type such as `BIGINT UNSIGNED` or `DECIMAL(10,2)` is numeric.
+// The MySQL protocol reports unsigned types as, e.g., `UNSIGNED BIGINT`.
+func isNumericDataType(dataType string) bool {
+	name, _, _ := strings.Cut(strings.ToUpper(strings.TrimSpace(dataType)), "(")
+	name, _, _ = strings.Cut(strings.TrimPrefix(name, "UNSIGNED "), " ")
+
+	return numericDataTypes[name]
+}
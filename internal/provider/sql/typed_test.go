@@ -0,0 +1,183 @@
+package sql_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeResultSet(t *testing.T, body string) sql.QueryResultSet {
+	t.Helper()
+
+	var resp sql.QueryRowsResponse
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&resp))
+	require.Len(t, resp.Results, 1)
+
+	return resp.Results[0]
+}
+
+func TestTypedRows(t *testing.T) {
+	t.Parallel()
+
+	set := decodeResultSet(t, `{"results":[{
+		"columns":[
+			{"name":"id","dataType":"BIGINT","nullable":false},
+			{"name":"price","dataType":"DECIMAL(20,2)","nullable":true},
+			{"name":"active","dataType":"BOOL","nullable":true},
+			{"name":"note","dataType":"TEXT","nullable":true},
+			{"name":"attrs","dataType":"JSON","nullable":true}
+		],
+		"rows":[
+			{"id":9223372036854775807,"price":"12.50","active":true,"note":"","attrs":{"a":1}},
+			{"id":2,"price":null,"active":null,"note":null,"attrs":null}
+		]
+	}]}`)
+
+	typed, diags := sql.TypedRows(set.Columns, set.Rows)
+	require.False(t, diags.HasError(), diags)
+
+	objectType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":     types.NumberType,
+		"price":  types.NumberType,
+		"active": types.BoolType,
+		"note":   types.StringType,
+		"attrs":  types.StringType,
+	}}
+
+	maxInt, _, err := big.ParseFloat("9223372036854775807", 10, 512, big.ToNearestEven)
+	require.NoError(t, err)
+	price, _, err := big.ParseFloat("12.50", 10, 512, big.ToNearestEven)
+	require.NoError(t, err)
+
+	require.Equal(t, types.DynamicValue(types.ListValueMust(objectType, []attr.Value{
+		types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"id":     types.NumberValue(maxInt),
+			"price":  types.NumberValue(price),
+			"active": types.BoolValue(true),
+			"note":   types.StringValue(""),
+			"attrs":  types.StringValue(`{"a":1}`),
+		}),
+		types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"id":     types.NumberValue(big.NewFloat(2)),
+			"price":  types.NumberNull(),
+			"active": types.BoolNull(),
+			"note":   types.StringNull(),
+			"attrs":  types.StringNull(),
+		}),
+	})).String(), typed.String())
+}
+
+func TestTypedRowsWithoutColumnMetadata(t *testing.T) {
+	t.Parallel()
+
+	set := decodeResultSet(t, `{"results":[{"rows":[{"name":"a","count":1,"code":"007"},{"name":"b","count":null,"code":"42"}]}]}`)
+
+	columns := sql.ColumnsToTFList(sql.ResultColumnsForTest(set))
+	require.Equal(t, []string{"code", "count", "name"}, columnNames(t, columns), "the names are sorted without metadata")
+
+	typed, diags := sql.TypedRows(sql.ResultColumnsForTest(set), set.Rows)
+	require.False(t, diags.HasError(), diags)
+
+	list, ok := typed.UnderlyingValue().(types.List)
+	require.True(t, ok)
+	require.Equal(t, types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"count": types.NumberType,
+		"code":  types.StringType,
+	}}, list.ElementType(t.Context()), "strings stay strings unless the column is numeric")
+}
+
+func TestTypedRowsEmpty(t *testing.T) {
+	t.Parallel()
+
+	typed, diags := sql.TypedRows([]sql.QueryColumn{{Name: "id", DataType: "INT"}}, nil)
+	require.False(t, diags.HasError(), diags)
+
+	list, ok := typed.UnderlyingValue().(types.List)
+	require.True(t, ok)
+	require.Empty(t, list.Elements())
+	require.Equal(t, types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.NumberType}}, list.ElementType(t.Context()))
+}
+
+func TestColumnsToTFList(t *testing.T) {
+	t.Parallel()
+
+	columns := sql.ColumnsToTFList([]sql.QueryColumn{
+		{Name: "id", DataType: "BIGINT"},
+		{Name: "email", DataType: "VARCHAR", Nullable: true},
+	})
+	require.Equal(t, []string{"id", "email"}, columnNames(t, columns), "the select-list order is kept")
+
+	var decoded []struct {
+		Name     string  `tfsdk:"name"`
+		Type     *string `tfsdk:"type"`
+		Nullable bool    `tfsdk:"nullable"`
+	}
+	require.False(t, columns.ElementsAs(t.Context(), &decoded, false).HasError())
+	require.Equal(t, "VARCHAR", *decoded[1].Type)
+	require.True(t, decoded[1].Nullable)
+}
+
+func columnNames(t *testing.T, columns types.List) []string {
+	t.Helper()
+
+	names := make([]string, 0, len(columns.Elements()))
+	for _, element := range columns.Elements() {
+		object, ok := element.(types.Object)
+		require.True(t, ok)
+
+		name, ok := object.Attributes()["name"].(types.String)
+		require.True(t, ok)
+
+		names = append(names, name.ValueString())
+	}
+
+	return names
+}
+
+func TestResultSets(t *testing.T) {
+	t.Parallel()
+
+	var resp sql.QueryRowsResponse
+	dec := json.NewDecoder(strings.NewReader(`{"results":[
+		{"columns":[{"name":"users","dataType":"BIGINT","nullable":false}],"rows":[{"users":42}]},
+		{"columns":[{"name":"name","dataType":"VARCHAR","nullable":true}],"rows":[{"name":"a"},{"name":"b"}]}
+	]}`))
+	dec.UseNumber()
+	require.NoError(t, dec.Decode(&resp))
+
+	sets, diags := sql.ResultSetsForTest(t.Context(), &resp)
+	require.False(t, diags.HasError(), diags)
+
+	tuple, ok := sets.UnderlyingValue().(types.Tuple)
+	require.True(t, ok)
+	require.Len(t, tuple.Elements(), 2)
+
+	second, ok := tuple.Elements()[1].(types.Object)
+	require.True(t, ok)
+
+	rows, ok := second.Attributes()["rows"].(types.List)
+	require.True(t, ok)
+	require.Len(t, rows.Elements(), 2)
+
+	typedRows, ok := second.Attributes()["typed_rows"].(types.List)
+	require.True(t, ok)
+	require.Equal(t, types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType}}, typedRows.ElementType(t.Context()),
+		"each result set has its own row type")
+
+	columns, ok := second.Attributes()["columns"].(types.List)
+	require.True(t, ok)
+	require.Equal(t, []string{"name"}, columnNames(t, columns))
+
+	empty, diags := sql.ResultSetsForTest(t.Context(), &sql.QueryRowsResponse{})
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, types.DynamicValue(types.TupleValueMust([]attr.Type{}, []attr.Value{})), empty)
+}
@@ -0,0 +1,89 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// explainableKeywords are the first words of the statements that EXPLAIN supports.
+var explainableKeywords = []string{"SELECT", "WITH", "INSERT", "REPLACE", "UPDATE", "DELETE"}
+
+// explainedStatements returns the statements that can be validated with EXPLAIN: the statements
+// up to the first one that EXPLAIN does not support, e.g., DDL. The statements after it may depend
+// on its effects, such as an INSERT into the table created by the CREATE TABLE before it.
+func explainedStatements(statements []string) []string {
+	for i, statement := range statements {
+		if !isOneOf(firstKeyword(statement), explainableKeywords) {
+			return statements[:i]
+		}
+	}
+
+	return statements
+}
+
+// explainStatements validates statements by running EXPLAIN for them, which parses the statements
+// and resolves the objects they reference without running them. Statements that EXPLAIN does not
+// support are not validated, which is reported as a warning on the attribute. The first failing statement
+// is reported as an error on the attribute, and an unreachable workspace as a warning, since the statements
+// may still succeed during apply.
+func explainStatements(ctx context.Context, client *Client, database string, attribute path.Path, statements []string, args []any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	explained := explainedStatements(statements)
+	for _, statement := range explained {
+		_, err := client.QueryRows(ctx, ExecRequest{
+			SQL:      "EXPLAIN " + statement,
+			Args:     args,
+			Database: database,
+		})
+		if err == nil {
+			continue
+		}
+
+		serr := DiagnosticFromError(err)
+		if IsUnreachable(err) {
+			diags.AddAttributeWarning(attribute, "SQL not validated", fmt.Sprintf("%s: %s", serr.Summary, serr.Detail))
+
+			return diags
+		}
+
+		diags.AddAttributeError(attribute, fmt.Sprintf("Invalid SQL in %s", attribute), fmt.Sprintf("%s\n\nStatement: %s", serr.Detail, statement))
+
+		return diags
+	}
+
+	if len(explained) < len(statements) {
+		skipped := statements[len(explained)]
+		diags.AddAttributeWarning(attribute, fmt.Sprintf("SQL in %s not fully validated", attribute), fmt.Sprintf(
+			"Statement %d of %d and the statements after it are not validated, since EXPLAIN does not support %s statements "+
+				"and the statements after it may depend on its effects.\n\nStatement: %s",
+			len(explained)+1, len(statements), firstKeyword(skipped), skipped,
+		))
+	}
+
+	return diags
+}
+
+// firstKeyword returns the first word of a statement in upper case, skipping comments and opening parentheses.
+func firstKeyword(statement string) string {
+	for i := 0; i < len(statement); i++ {
+		switch {
+		case strings.HasPrefix(statement[i:], "-- "):
+			i = skipLineComment(statement, i)
+		case strings.HasPrefix(statement[i:], "/*"):
+			i = skipBlockComment(statement, i)
+		case unicode.IsSpace(rune(statement[i])) || statement[i] == '(':
+		default:
+			word, _ := readWord(statement, i)
+
+			return strings.ToUpper(word)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,96 @@
+package sql_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainedStatements(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		statements []string
+		want       []string
+	}{
+		{
+			name:       "dml",
+			statements: []string{"INSERT INTO t VALUES (1)", "update t SET a = 1", "DELETE FROM t"},
+			want:       []string{"INSERT INTO t VALUES (1)", "update t SET a = 1", "DELETE FROM t"},
+		},
+		{
+			name:       "comments and parentheses",
+			statements: []string{"-- load\n/* rows */ (SELECT 1)", "WITH x AS (SELECT 1) SELECT * FROM x"},
+			want:       []string{"-- load\n/* rows */ (SELECT 1)", "WITH x AS (SELECT 1) SELECT * FROM x"},
+		},
+		{
+			name:       "stops at ddl",
+			statements: []string{"INSERT INTO a VALUES (1)", "CREATE TABLE b (id INT)", "INSERT INTO b VALUES (1)"},
+			want:       []string{"INSERT INTO a VALUES (1)"},
+		},
+		{
+			name:       "ddl only",
+			statements: []string{"CREATE DATABASE app"},
+			want:       []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.want, sql.ExplainedStatementsForTest(tc.statements))
+		})
+	}
+}
+
+func TestExplainStatements(t *testing.T) {
+	var explained []string
+	dataAPI := testutil.MockDataAPIServer(t, testutil.DataAPIHandler{
+		Query: func(req testutil.DataAPIRequest) ([]map[string]any, error) {
+			explained = append(explained, req.SQL)
+			if strings.Contains(req.SQL, "missing") {
+				return nil, errors.New("Table 'app.missing' doesn't exist")
+			}
+
+			return []map[string]any{{"EXPLAIN": "Project"}}, nil
+		},
+	})
+
+	client := sql.NewClientForTest(dataAPI(), "https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+
+	diags := sql.ExplainStatementsForTest(t.Context(), client, "execute", []string{"INSERT INTO t VALUES (1)", "DELETE FROM t"})
+	require.False(t, diags.HasError())
+	require.Equal(t, []string{"EXPLAIN INSERT INTO t VALUES (1)", "EXPLAIN DELETE FROM t"}, explained)
+
+	diags = sql.ExplainStatementsForTest(t.Context(), client, "execute", []string{"DELETE FROM missing", "DELETE FROM t"})
+	require.True(t, diags.HasError())
+	require.Equal(t, "Invalid SQL in execute", diags[0].Summary())
+	require.Contains(t, diags[0].Detail(), "Table 'app.missing' doesn't exist")
+	require.Contains(t, diags[0].Detail(), "Statement: DELETE FROM missing")
+	require.Equal(t, "EXPLAIN DELETE FROM missing", explained[len(explained)-1])
+
+	diags = sql.ExplainStatementsForTest(t.Context(), client, "execute", []string{"DELETE FROM t", "CREATE TABLE u (id INT)", "INSERT INTO u VALUES (1)"})
+	require.False(t, diags.HasError())
+	require.Equal(t, 1, diags.WarningsCount())
+	require.Contains(t, diags[0].Detail(), "Statement 2 of 3 and the statements after it are not validated, since EXPLAIN does not support CREATE statements")
+	require.Equal(t, "EXPLAIN DELETE FROM t", explained[len(explained)-1])
+}
+
+func TestExplainStatementsWarnsWhenUnreachable(t *testing.T) {
+	dataAPI := testutil.MockDataAPIServer(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	client := sql.NewClientForTest(dataAPI(), "https://"+testutil.TestWorkspaceEndpoint, "admin", "secret")
+
+	diags := sql.ExplainStatementsForTest(t.Context(), client, "query", []string{"SELECT 1"})
+	require.False(t, diags.HasError())
+	require.Equal(t, 1, diags.WarningsCount())
+}
@@ -0,0 +1,445 @@
+package sqlobjects
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ProcedureResourceName = "procedure"
+	FunctionResourceName  = "function"
+	ViewResourceName      = "view"
+)
+
+// objectKind describes the procedures, the functions, and the views, which share the same lifecycle.
+type objectKind struct {
+	// ResourceName is the name of the resource without the provider prefix.
+	ResourceName string
+	// Keyword is the object type in the statements, e.g., CREATE PROCEDURE.
+	Keyword string
+	// Separator is placed between the name and the definition in CREATE statements.
+	Separator string
+	// ExistsSQL lists the object by database and name.
+	ExistsSQL string
+	// Description is the paragraph that explains the kind in the schema.
+	Description string
+	// DefinitionDescription explains the definition attribute.
+	DefinitionDescription string
+}
+
+var (
+	procedureKind = objectKind{
+		ResourceName: ProcedureResourceName,
+		Keyword:      "PROCEDURE",
+		ExistsSQL: "SELECT ROUTINE_NAME FROM information_schema.ROUTINES " +
+			"WHERE ROUTINE_SCHEMA = ? AND ROUTINE_NAME = ? AND ROUTINE_TYPE = 'PROCEDURE'",
+		Description: "A stored procedure runs procedural SQL with `CALL` or `ECHO`.",
+		DefinitionDescription: "The definition of the procedure after its name, starting with the parameter list, " +
+			"e.g., `(id BIGINT) AS BEGIN DELETE FROM orders WHERE order_id = id; END`.",
+	}
+	functionKind = objectKind{
+		ResourceName: FunctionResourceName,
+		Keyword:      "FUNCTION",
+		ExistsSQL: "SELECT ROUTINE_NAME FROM information_schema.ROUTINES " +
+			"WHERE ROUTINE_SCHEMA = ? AND ROUTINE_NAME = ? AND ROUTINE_TYPE <> 'PROCEDURE'",
+		Description: "A user-defined scalar or table-valued function is called from queries.",
+		DefinitionDescription: "The definition of the function after its name, starting with the parameter list, " +
+			"e.g., `(amount DECIMAL(10, 2)) RETURNS DECIMAL(10, 2) AS BEGIN RETURN amount * 1.2; END`.",
+	}
+	viewKind = objectKind{
+		ResourceName: ViewResourceName,
+		Keyword:      "VIEW",
+		Separator:    " AS ",
+		ExistsSQL:    "SELECT TABLE_NAME FROM information_schema.VIEWS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?",
+		Description:  "A view is a named query that is used as a table.",
+		DefinitionDescription: "The `SELECT` statement of the view, e.g., `SELECT id, amount FROM orders WHERE amount > 100`. " +
+			"The workspace stores the statement in a rewritten form, so an imported view shows an in-place update to the configured statement.",
+	}
+)
+
+var (
+	_ resource.Resource                = &objectResource{}
+	_ resource.ResourceWithConfigure   = &objectResource{}
+	_ resource.ResourceWithImportState = &objectResource{}
+)
+
+type objectResourceModel struct {
+	sql.ConnectionModel
+	ID             types.String `tfsdk:"id"`
+	Database       types.String `tfsdk:"database"`
+	Name           types.String `tfsdk:"name"`
+	Definition     types.String `tfsdk:"definition"`
+	DefinitionHash types.String `tfsdk:"definition_hash"`
+}
+
+type objectResource struct {
+	sql.Connector
+	kind objectKind
+}
+
+func NewProcedureResource() resource.Resource {
+	return &objectResource{kind: procedureKind}
+}
+
+func NewFunctionResource() resource.Resource {
+	return &objectResource{kind: functionKind}
+}
+
+func NewViewResource() resource.Resource {
+	return &objectResource{kind: viewKind}
+}
+
+func (r *objectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, r.kind.ResourceName)
+}
+
+func (r *objectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	kind := strings.ToLower(r.kind.Keyword)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manage a %s of a SingleStore Helios workspace via the Data API. %s "+
+			"Definition changes run `CREATE OR REPLACE %s`, so the %s is updated in place, "+
+			"and changes that only affect whitespace or comments are saved without running a statement. "+
+			"A %s changed outside of Terraform is detected by comparing the hash of the normalized `SHOW CREATE %s` output with `definition_hash`, "+
+			"and a %s dropped outside of Terraform is created again on the next apply.",
+			kind, r.kind.Description, r.kind.Keyword, kind, kind, r.kind.Keyword, kind,
+		),
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The identifier of the %s in the form `<endpoint>/<database>/<name>`.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The database of the %s. Changing this value forces replacement.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The name of the %s. Changing this value forces replacement.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"definition": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: r.kind.DefinitionDescription + " Changing this value updates the " + kind + " in place.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"definition_hash": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The SHA-256 hash of the normalized `SHOW CREATE %s` output after the last apply.", r.kind.Keyword),
+			},
+		}),
+	}
+}
+
+func (r *objectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan objectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	// A plain CREATE fails instead of silently taking over an existing object.
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: createStatement(r.kind, plan, false), Database: plan.Database.ValueString()}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Database.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(r.readAfterApply(ctx, client, plan, &resp.State)...)
+}
+
+func (r *objectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state objectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	statement, err := showCreate(ctx, client, r.kind, state.Database.ValueString(), state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if statement == "" {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if hash := definitionHash(statement); hash != state.DefinitionHash.ValueString() {
+		// The definition reported by the workspace differs from the configuration,
+		// which plans CREATE OR REPLACE with the configured definition.
+		state.Definition = types.StringValue(extractDefinition(r.kind, state.Name.ValueString(), statement))
+		state.DefinitionHash = types.StringValue(hash)
+	}
+
+	state.ConnectionModel = state.ForState()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *objectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state objectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if normalizeDefinition(plan.Definition.ValueString()) != normalizeDefinition(state.Definition.ValueString()) {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: createStatement(r.kind, plan, true), Database: plan.Database.ValueString()}); err != nil {
+			serr := sql.DiagnosticFromError(err)
+			resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readAfterApply(ctx, client, plan, &resp.State)...)
+}
+
+func (r *objectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state objectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	statement := fmt.Sprintf("DROP %s IF EXISTS %s", r.kind.Keyword, sql.QuoteIdentifier(state.Name.ValueString()))
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement, Database: state.Database.ValueString()}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *objectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *objectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := sql.ImportConnection(ctx, req, resp, "`<endpoint>/<database>/<name>`", 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), names[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), names[1])...)
+}
+
+// readAfterApply saves the hash of the definition reported by the workspace.
+func (r *objectResource) readAfterApply(ctx context.Context, client *sql.Client, plan objectResourceModel, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statement, err := showCreate(ctx, client, r.kind, plan.Database.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		diags.AddError(serr.Summary, serr.Detail)
+
+		return diags
+	}
+
+	if statement == "" {
+		kind := strings.ToLower(r.kind.Keyword)
+		diags.AddError(
+			"Missing "+kind,
+			fmt.Sprintf("The %s %s.%s does not exist after the apply. %s",
+				kind, plan.Database.ValueString(), plan.Name.ValueString(), config.CreateProviderIssueErrorDetail,
+			),
+		)
+
+		return diags
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.DefinitionHash = types.StringValue(definitionHash(statement))
+
+	return state.Set(ctx, &plan)
+}
+
+// createStatement returns CREATE or, with replace, CREATE OR REPLACE for the object.
+func createStatement(kind objectKind, model objectResourceModel, replace bool) string {
+	verb := "CREATE"
+	if replace {
+		verb = "CREATE OR REPLACE"
+	}
+
+	return fmt.Sprintf("%s %s %s%s%s",
+		verb, kind.Keyword, sql.QuoteIdentifier(model.Name.ValueString()), kind.Separator, strings.TrimSpace(model.Definition.ValueString()),
+	)
+}
+
+// showCreate returns the CREATE statement reported by the workspace, or an empty string if the object does not exist.
+func showCreate(ctx context.Context, client *sql.Client, kind objectKind, database, name string) (string, error) {
+	// SHOW CREATE fails for a missing object with an error that does not tell it from other failures.
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{SQL: kind.ExistsSQL, Args: []any{database, name}})
+	if err != nil || len(rows) == 0 {
+		return "", err
+	}
+
+	rows, err = sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:      fmt.Sprintf("SHOW CREATE %s %s", kind.Keyword, sql.QuoteIdentifier(name)),
+		Database: database,
+	})
+	if err != nil || len(rows) == 0 {
+		return "", err
+	}
+
+	// The statement is in the "Create Procedure", "Create Function", or "Create View" column.
+	for column, value := range rows[0] {
+		if strings.HasPrefix(column, "Create ") {
+			return value, nil
+		}
+	}
+
+	return "", fmt.Errorf("SHOW CREATE %s %s returned no CREATE statement", kind.Keyword, name)
+}
+
+// extractDefinition returns the part of the CREATE statement after the name of the object,
+// which is what the definition attribute holds.
+func extractDefinition(kind objectKind, name, statement string) string {
+	marker := kind.Keyword + " " + sql.QuoteIdentifier(name)
+
+	i := strings.Index(strings.ToUpper(statement), strings.ToUpper(marker))
+	if i < 0 {
+		return strings.TrimSpace(statement)
+	}
+
+	definition := strings.TrimSpace(statement[i+len(marker):])
+	if separator := strings.TrimSpace(kind.Separator); separator != "" && len(definition) > len(separator) &&
+		strings.EqualFold(definition[:len(separator)+1], separator+" ") {
+		definition = strings.TrimSpace(definition[len(separator):])
+	}
+
+	return definition
+}
+
+func definitionHash(definition string) string {
+	sum := sha256.Sum256([]byte(normalizeDefinition(definition)))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeDefinition removes comments, collapses whitespace, and drops the trailing semicolons
+// outside of string literals and quoted identifiers, so that formatting changes compare equal.
+func normalizeDefinition(definition string) string {
+	var b strings.Builder
+
+	space := false
+	writeSpace := func() {
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+
+		space = false
+	}
+
+	for i := 0; i < len(definition); i++ {
+		c := definition[i]
+
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			writeSpace()
+
+			end := i + 1
+			for end < len(definition) && definition[end] != c {
+				if definition[end] == '\\' && c != '`' {
+					end++
+				}
+				end++
+			}
+
+			end = min(end, len(definition)-1)
+			b.WriteString(definition[i : end+1])
+			i = end
+		case c == '-' && strings.HasPrefix(definition[i:], "-- "):
+			end := strings.IndexByte(definition[i:], '\n')
+			if end < 0 {
+				end = len(definition) - i
+			}
+
+			i += end - 1
+			space = true
+		case c == '/' && strings.HasPrefix(definition[i:], "/*"):
+			end := strings.Index(definition[i+2:], "*/")
+			if end < 0 {
+				end = len(definition) - i - 2
+			}
+
+			i += end + 3
+			space = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = true
+		default:
+			writeSpace()
+			b.WriteByte(c)
+		}
+	}
+
+	return strings.TrimRight(b.String(), "; ")
+}
@@ -0,0 +1,55 @@
+package sqlobjects
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDefinition(t *testing.T) {
+	require.Equal(t,
+		"(id BIGINT) AS BEGIN DELETE FROM orders WHERE order_id = id; END",
+		normalizeDefinition("(id BIGINT)\nAS\nBEGIN\n  -- Delete the order.\n  DELETE FROM orders  WHERE order_id = id; /* by id */\nEND;\n"),
+	)
+	require.Equal(t, "SELECT 'a  -- b' AS x, `c  d`", normalizeDefinition("SELECT   'a  -- b' AS x,\n`c  d`"), "literals and identifiers are kept")
+	require.Equal(t, `SELECT 'it\'s  here'`, normalizeDefinition(`SELECT 'it\'s  here'`))
+	require.Equal(t, "SELECT 'open", normalizeDefinition("SELECT 'open"))
+	require.Equal(t, "SELECT 1", normalizeDefinition("SELECT 1 /* open"))
+	require.Equal(t, definitionHash("SELECT 1"), definitionHash("  SELECT\t1;  "))
+	require.NotEqual(t, definitionHash("SELECT 1"), definitionHash("SELECT 2"))
+}
+
+func TestCreateStatement(t *testing.T) {
+	model := objectResourceModel{
+		Name:       types.StringValue("delete_order"),
+		Definition: types.StringValue("\n(id BIGINT) AS BEGIN DELETE FROM orders WHERE order_id = id; END\n"),
+	}
+	require.Equal(t,
+		"CREATE PROCEDURE `delete_order`(id BIGINT) AS BEGIN DELETE FROM orders WHERE order_id = id; END",
+		createStatement(procedureKind, model, false),
+	)
+
+	model = objectResourceModel{
+		Name:       types.StringValue("big_orders"),
+		Definition: types.StringValue("SELECT id FROM orders WHERE amount > 100"),
+	}
+	require.Equal(t,
+		"CREATE OR REPLACE VIEW `big_orders` AS SELECT id FROM orders WHERE amount > 100",
+		createStatement(viewKind, model, true),
+	)
+}
+
+func TestExtractDefinition(t *testing.T) {
+	require.Equal(t,
+		"(id BIGINT) RETURNS void AS BEGIN DELETE FROM orders WHERE order_id = id; END",
+		extractDefinition(procedureKind, "delete_order",
+			"CREATE OR REPLACE PROCEDURE `delete_order`(id BIGINT) RETURNS void AS BEGIN DELETE FROM orders WHERE order_id = id; END"),
+	)
+	require.Equal(t,
+		"SELECT `orders`.`id` AS `id` FROM `orders`",
+		extractDefinition(viewKind, "big_orders",
+			"CREATE DEFINER=`admin`@`%` SCHEMA_BINDING=OFF SQL SECURITY DEFINER VIEW `big_orders` AS SELECT `orders`.`id` AS `id` FROM `orders`"),
+	)
+	require.Equal(t, "SELECT 1", extractDefinition(viewKind, "missing", " SELECT 1 "), "the statement is kept if the name is not found")
+}
@@ -0,0 +1,215 @@
+package sqlobjects_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var createProcedureRegexp = regexp.MustCompile("(?s)^CREATE (OR REPLACE )?PROCEDURE `delete_order`(.*)$")
+
+// fakeProcedures keeps the procedure `app`.`delete_order` of a mock workspace.
+type fakeProcedures struct {
+	*testutil.FakeWorkspace
+	definition string
+}
+
+func newFakeProcedures(t *testing.T) *fakeProcedures {
+	t.Helper()
+
+	w := &fakeProcedures{}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: createProcedureRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				require.Equal(t, "app", req.Database)
+				w.Record(req.SQL)
+
+				if m[1] == "" && w.definition != "" {
+					return fmt.Errorf("procedure delete_order already exists")
+				}
+
+				w.definition = m[2]
+
+				return nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("DROP PROCEDURE IF EXISTS `delete_order`"),
+			Exec: func(req testutil.DataAPIRequest, _ []string) error {
+				require.Equal(t, "app", req.Database)
+				w.Record(req.SQL)
+
+				w.definition = ""
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile("^" + regexp.QuoteMeta("SELECT ROUTINE_NAME FROM information_schema.ROUTINES")),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				require.Equal(t, []any{"app", "delete_order"}, req.Args)
+
+				if w.definition == "" {
+					return nil, nil
+				}
+
+				return []map[string]any{{"ROUTINE_NAME": "delete_order"}}, nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("SHOW CREATE PROCEDURE `delete_order`"),
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				require.Equal(t, "app", req.Database)
+
+				if w.definition == "" {
+					return nil, nil
+				}
+
+				return []map[string]any{{
+					"Procedure":        "delete_order",
+					"Create Procedure": "CREATE OR REPLACE PROCEDURE `delete_order`" + w.definition,
+				}}, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeProcedures) setOutsideTerraform(definition string) {
+	w.Do(func() {
+		w.definition = definition
+	})
+}
+
+func procedureConfig(definition string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_procedure" "this" {
+  endpoint   = %q
+  password   = "secret"
+  database   = "app"
+  name       = "delete_order"
+  definition = %q
+}
+`, testutil.TestWorkspaceEndpoint, definition)
+}
+
+func TestCRUDProcedure(t *testing.T) {
+	w := newFakeProcedures(t)
+	t.Setenv(config.EnvSQLUserPassword, "secret") // The imported procedure reads the password from the environment.
+
+	const (
+		definition  = "(id BIGINT) AS BEGIN DELETE FROM orders WHERE order_id = id; END"
+		reformatted = "(id BIGINT)\nAS\nBEGIN\n  -- Delete a single order.\n  DELETE FROM orders WHERE order_id = id;\nEND\n"
+		updated     = "(id BIGINT) AS BEGIN DELETE FROM orders WHERE order_id = id AND id > 0; END"
+	)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: procedureConfig(definition),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_procedure.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/app/delete_order"),
+					resource.TestCheckResourceAttr("singlestoredb_procedure.this", "definition", definition),
+					resource.TestCheckResourceAttrSet("singlestoredb_procedure.this", "definition_hash"),
+				),
+			},
+			{
+				// Formatting changes are saved without a statement.
+				Config: procedureConfig(reformatted),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_procedure.this", "definition", reformatted),
+				),
+			},
+			{
+				Config: procedureConfig(updated),
+			},
+			{
+				// A procedure replaced outside of Terraform is replaced again with the configured definition.
+				PreConfig: func() {
+					w.setOutsideTerraform("(id BIGINT) AS BEGIN DELETE FROM orders; END")
+				},
+				Config: procedureConfig(updated),
+			},
+			{
+				ResourceName:            "singlestoredb_procedure.this",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/app/delete_order",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE PROCEDURE `delete_order`" + definition,
+		"CREATE OR REPLACE PROCEDURE `delete_order`" + updated,
+		"CREATE OR REPLACE PROCEDURE `delete_order`" + updated,
+		"DROP PROCEDURE IF EXISTS `delete_order`",
+	}, w.Executed())
+}
+
+func TestProcedureDroppedOutsideOfTerraform(t *testing.T) {
+	w := newFakeProcedures(t)
+
+	const definition = "() AS BEGIN DELETE FROM orders; END"
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: procedureConfig(definition),
+			},
+			{
+				PreConfig: func() {
+					w.setOutsideTerraform("")
+				},
+				Config: procedureConfig(definition),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE PROCEDURE `delete_order`" + definition,
+		"CREATE PROCEDURE `delete_order`" + definition,
+		"DROP PROCEDURE IF EXISTS `delete_order`",
+	}, w.Executed())
+}
+
+func TestSQLObjectResourcesIntegration(t *testing.T) {
+	for name, example := range map[string]string{
+		"singlestoredb_procedure.delete_order": examples.ProcedureResource,
+		"singlestoredb_function.with_tax":      examples.FunctionResource,
+		"singlestoredb_view.big_orders":        examples.ViewResource,
+	} {
+		t.Run(name, func(t *testing.T) {
+			testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+				APIKey:             os.Getenv(config.EnvTestAPIKey),
+				WorkspaceGroupName: "example",
+			}, resource.TestCase{
+				Steps: []resource.TestStep{
+					{
+						Config: testutil.UpdatableConfig(example).
+							WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+							String(),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(name, "definition_hash"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,605 @@
+package sqlusers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	GrantResourceName = "sql_grant"
+
+	// rolePrefix marks roles in the grantee part of the ID, e.g., `<endpoint>/role:<role>/<scope>`.
+	rolePrefix = "role:"
+	// usagePrivilege is listed by SHOW GRANTS for grantees without any privileges.
+	usagePrivilege = "USAGE"
+	// grantOptionSuffix is appended by SHOW GRANTS to the grants that can be passed on.
+	grantOptionSuffix = " WITH GRANT OPTION"
+)
+
+var (
+	_ resource.Resource                = &grantResource{}
+	_ resource.ResourceWithConfigure   = &grantResource{}
+	_ resource.ResourceWithImportState = &grantResource{}
+
+	scopeRegexp     = regexp.MustCompile(`^(\*|[^.*]+)\.(\*|[^.*]+)$`)
+	privilegeRegexp = regexp.MustCompile(`^[A-Z][A-Z_ ]*[A-Z]$`)
+)
+
+type grantResourceModel struct {
+	sql.ConnectionModel
+	ID              types.String `tfsdk:"id"`
+	User            types.String `tfsdk:"user"`
+	Host            types.String `tfsdk:"host"`
+	Role            types.String `tfsdk:"role"`
+	Scope           types.String `tfsdk:"scope"`
+	Privileges      types.Set    `tfsdk:"privileges"`
+	WithGrantOption types.Bool   `tfsdk:"with_grant_option"`
+}
+
+// grantInfo is the privileges of a grantee on a scope.
+type grantInfo struct {
+	Privileges      []string
+	WithGrantOption bool
+}
+
+type grantResource struct {
+	sql.Connector
+}
+
+func NewGrantResource() resource.Resource {
+	return &grantResource{}
+}
+
+func (r *grantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, GrantResourceName)
+}
+
+func (r *grantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the privileges of a user or a role on a scope of a SingleStore Helios workspace via the Data API. " +
+			"The resource owns all the privileges of the grantee on the scope: the 'apply' action compares the configured privileges with `SHOW GRANTS` " +
+			"and runs only the `GRANT` and `REVOKE` statements for the difference, and the 'destroy' action revokes the privileges.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the grant in the form `<endpoint>/<user>@<host>/<scope>` or `<endpoint>/role:<role>/<scope>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the user to grant the privileges to. Exactly one of `user` and `role` must be set. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user"), path.MatchRoot("role")),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("%"),
+				MarkdownDescription: "The host pattern of the user. Defaults to `%`. Ignored for roles. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the role to grant the privileges to. Exactly one of `user` and `role` must be set. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The objects the privileges apply to in the form `<database>.<table>`, e.g., `my_app_db.*` for all the tables of a database or `*.*` for the whole workspace. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(scopeRegexp, "must be of the form <database>.<table>, where either part can be *"),
+				},
+			},
+			"privileges": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The privileges in upper case as listed by `SHOW GRANTS`, e.g., `SELECT`, `CREATE VIEW`, or `ALL PRIVILEGES`.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(privilegeRegexp, "must be an upper-case privilege, e.g., SELECT or CREATE VIEW"),
+						stringvalidator.NoneOf(usagePrivilege),
+					),
+				},
+			},
+			"with_grant_option": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the grantee can grant the privileges to others. Defaults to `false`.",
+			},
+		}),
+	}
+}
+
+func (r *grantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan grantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	desired, diags := desiredGrant(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource owns all the privileges of the grantee on the scope, so privileges granted before are revoked.
+	current, err := readGrant(ctx, client, plan)
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ID = types.StringValue(grantID(plan))
+	r.apply(ctx, client, plan, desired, current, &resp.State, &resp.Diagnostics)
+}
+
+func (r *grantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state grantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	exists, err := granteeExists(ctx, client, state)
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if !exists {
+		// The grantee was dropped along with its privileges.
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	current, err := readGrant(ctx, client, state)
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if len(current.Privileges) == 0 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, toGrantResourceModel(state, current))...)
+}
+
+func (r *grantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan grantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	desired, diags := desiredGrant(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The privileges are read again rather than taken from the state, which may be stale,
+	// e.g., with -refresh=false, so that the statements match the actual difference.
+	current, err := readGrant(ctx, client, plan)
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	r.apply(ctx, client, plan, desired, current, &resp.State, &resp.Diagnostics)
+}
+
+func (r *grantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state grantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	exists, err := granteeExists(ctx, client, state)
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if !exists {
+		return
+	}
+
+	current, err := readGrant(ctx, client, state)
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+
+	for _, statement := range grantStatements(state, grantInfo{}, current) {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement}); err != nil {
+			sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+			return
+		}
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *grantResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *grantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	const format = "`<endpoint>/<user>@<host>/<scope>` or `<endpoint>/role:<role>/<scope>`"
+
+	names := sql.ImportConnection(ctx, req, resp, format, 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if role, ok := strings.CutPrefix(names[0], rolePrefix); ok && role != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), "%")...)
+	} else {
+		name, host, ok := splitUserHost(names[0])
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("Expected an import ID of the form %s, got %q.", format, req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), name)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+	}
+
+	if !scopeRegexp.MatchString(names[1]) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("The scope %q of the import ID is not of the form <database>.<table>.", names[1]),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), names[1])...)
+}
+
+// apply runs the statements that turn the current privileges into the desired ones and saves the privileges reported afterward.
+func (r *grantResource) apply(ctx context.Context, client *sql.Client, plan grantResourceModel, desired, current grantInfo, state *tfsdk.State, diags *diag.Diagnostics) {
+	for _, statement := range grantStatements(plan, desired, current) {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement}); err != nil {
+			serr := sql.DiagnosticFromError(err)
+			diags.AddError(serr.Summary, serr.Detail)
+
+			return
+		}
+	}
+
+	result, err := readGrant(ctx, client, plan)
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		diags.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	diags.Append(state.Set(ctx, toGrantResourceModel(plan, result))...)
+}
+
+func grantID(model grantResourceModel) string {
+	return sql.ImportID(model.Endpoint.ValueString(), granteeName(model), model.Scope.ValueString())
+}
+
+// granteeName returns the grantee part of the ID.
+func granteeName(model grantResourceModel) string {
+	if util.IsConfiguredString(model.Role) {
+		return rolePrefix + model.Role.ValueString()
+	}
+
+	return model.User.ValueString() + "@" + model.Host.ValueString()
+}
+
+// grantee returns the grantee as written in GRANT, REVOKE, and SHOW GRANTS.
+func grantee(model grantResourceModel) string {
+	if util.IsConfiguredString(model.Role) {
+		return "ROLE " + sql.QuoteString(model.Role.ValueString())
+	}
+
+	return sql.QuoteString(model.User.ValueString()) + "@" + sql.QuoteString(model.Host.ValueString())
+}
+
+// quoteScope quotes the database and the table of a scope, e.g., my_app_db.* becomes `my_app_db`.*.
+func quoteScope(scope string) string {
+	m := scopeRegexp.FindStringSubmatch(scope)
+	if m == nil {
+		return scope
+	}
+
+	parts := m[1:]
+	for i, part := range parts {
+		if part != "*" {
+			parts[i] = sql.QuoteIdentifier(part)
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// unquoteScope turns a scope listed by SHOW GRANTS, e.g., `my_app_db`.*, into the form of the scope attribute.
+func unquoteScope(scope string) string {
+	var b strings.Builder
+
+	quoted := false
+	for i := 0; i < len(scope); i++ {
+		c := scope[i]
+		switch {
+		case c == '`' && quoted && i+1 < len(scope) && scope[i+1] == '`':
+			b.WriteByte('`')
+			i++
+		case c == '`':
+			quoted = !quoted
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// grantStatements returns the REVOKE and GRANT statements that turn the current privileges into the desired ones.
+func grantStatements(model grantResourceModel, desired, current grantInfo) []string {
+	var result []string
+
+	scope := quoteScope(model.Scope.ValueString())
+	to := grantee(model)
+
+	if revoked := difference(current.Privileges, desired.Privileges); len(revoked) > 0 {
+		result = append(result, fmt.Sprintf("REVOKE %s ON %s FROM %s", strings.Join(revoked, ", "), scope, to))
+	}
+
+	if current.WithGrantOption && !desired.WithGrantOption {
+		result = append(result, fmt.Sprintf("REVOKE GRANT OPTION ON %s FROM %s", scope, to))
+	}
+
+	granted := difference(desired.Privileges, current.Privileges)
+	if desired.WithGrantOption && !current.WithGrantOption {
+		// The grant option is only added along with privileges.
+		granted = desired.Privileges
+	}
+
+	if len(granted) > 0 {
+		statement := fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(granted, ", "), scope, to)
+		if desired.WithGrantOption {
+			statement += grantOptionSuffix
+		}
+
+		result = append(result, statement)
+	}
+
+	return result
+}
+
+// difference returns the sorted elements of a that are not in b.
+func difference(a, b []string) []string {
+	var result []string
+	for _, s := range a {
+		if !slices.Contains(b, s) {
+			result = append(result, s)
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+func desiredGrant(ctx context.Context, model grantResourceModel) (grantInfo, diag.Diagnostics) {
+	var privileges []string
+	diags := model.Privileges.ElementsAs(ctx, &privileges, false)
+	slices.Sort(privileges)
+
+	return grantInfo{Privileges: privileges, WithGrantOption: model.WithGrantOption.ValueBool()}, diags
+}
+
+// readGrant returns the privileges of the grantee on the scope of the model as listed by SHOW GRANTS.
+func readGrant(ctx context.Context, client *sql.Client, model grantResourceModel) (grantInfo, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{SQL: "SHOW GRANTS FOR " + grantee(model)})
+	if err != nil {
+		return grantInfo{}, err
+	}
+
+	var statements []string
+	for _, row := range rows {
+		for _, statement := range row {
+			statements = append(statements, statement)
+		}
+	}
+
+	return parseGrants(statements, model.Scope.ValueString()), nil
+}
+
+// parseGrants collects the privileges on the scope from GRANT statements listed by SHOW GRANTS, e.g.,
+// GRANT SELECT, INSERT ON `my_app_db`.* TO 'app'@'%' WITH GRANT OPTION.
+func parseGrants(statements []string, scope string) grantInfo {
+	var result grantInfo
+
+	for _, statement := range statements {
+		privileges, grantScope, ok := splitShowGrant(statement)
+		if !ok || unquoteScope(grantScope) != scope {
+			continue
+		}
+
+		for _, privilege := range privileges {
+			privilege = strings.ToUpper(privilege)
+			// Column privileges, e.g., SELECT (`a`, `b`), are not managed by the resource.
+			if strings.Contains(privilege, "(") {
+				continue
+			}
+
+			if privilege != usagePrivilege && !slices.Contains(result.Privileges, privilege) {
+				result.Privileges = append(result.Privileges, privilege)
+			}
+		}
+
+		if strings.HasSuffix(statement, grantOptionSuffix) {
+			result.WithGrantOption = true
+		}
+	}
+
+	slices.Sort(result.Privileges)
+
+	return result
+}
+
+// splitShowGrant splits a statement listed by SHOW GRANTS, e.g., GRANT SELECT, INSERT ON `my_app_db`.* TO 'app'@'%',
+// into its privileges and its scope. Commas and spaces within backticks and parentheses, e.g., in the column
+// privilege SELECT (`a`, `b`) or in the scope `my db`.*, do not separate them.
+func splitShowGrant(statement string) ([]string, string, bool) {
+	rest, ok := strings.CutPrefix(statement, "GRANT ")
+	if !ok {
+		return nil, "", false
+	}
+
+	var privileges []string
+	start, depth, quoted := 0, 0, false
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+		switch {
+		case c == '`':
+			quoted = !quoted // An escaped backtick toggles twice.
+		case quoted:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case depth > 0:
+		case c == ',':
+			privileges = append(privileges, strings.TrimSpace(rest[start:i]))
+			start = i + 1
+		case strings.HasPrefix(rest[i:], " ON "):
+			privileges = append(privileges, strings.TrimSpace(rest[start:i]))
+
+			scope, ok := scopeOfShowGrant(rest[i+len(" ON "):])
+
+			return privileges, scope, ok
+		}
+	}
+
+	return nil, "", false
+}
+
+// scopeOfShowGrant returns the scope at the start of the rest of a SHOW GRANTS statement, which is followed by TO.
+func scopeOfShowGrant(rest string) (string, bool) {
+	quoted := false
+	for i := 0; i < len(rest); i++ {
+		switch {
+		case rest[i] == '`':
+			quoted = !quoted
+		case rest[i] == ' ' && !quoted:
+			return rest[:i], strings.HasPrefix(rest[i:], " TO ")
+		}
+	}
+
+	return "", false
+}
+
+func toGrantResourceModel(model grantResourceModel, info grantInfo) grantResourceModel {
+	model.ConnectionModel = model.ForState()
+	model.Privileges = types.SetValueMust(types.StringType, util.Map(info.Privileges, func(p string) attr.Value {
+		return types.StringValue(p)
+	}))
+	model.WithGrantOption = types.BoolValue(info.WithGrantOption)
+
+	return model
+}
+
+// granteeExists reports whether the user or the role of the model exists, since SHOW GRANTS fails for missing grantees.
+func granteeExists(ctx context.Context, client *sql.Client, model grantResourceModel) (bool, error) {
+	if util.IsConfiguredString(model.Role) {
+		return principalExists(ctx, client, roleKind, model.Role.ValueString())
+	}
+
+	info, err := readUser(ctx, client, model.User.ValueString(), model.Host.ValueString())
+
+	return info != nil, err
+}
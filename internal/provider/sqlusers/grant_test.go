@@ -0,0 +1,176 @@
+package sqlusers_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	grantRegexp  = regexp.MustCompile(`^GRANT (.+) ON (\S+) TO 'app'@'%'$`)
+	revokeRegexp = regexp.MustCompile(`^REVOKE (.+) ON (\S+) FROM 'app'@'%'$`)
+)
+
+// fakeGrants keeps the privileges of the user 'app'@'%' of a mock workspace by scope.
+type fakeGrants struct {
+	*testutil.FakeWorkspace
+	privileges map[string][]string
+}
+
+func newFakeGrants(t *testing.T) *fakeGrants {
+	t.Helper()
+
+	g := &fakeGrants{privileges: map[string][]string{}}
+
+	g.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: grantRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				g.Record(req.SQL)
+
+				for _, p := range strings.Split(m[1], ", ") {
+					if !slices.Contains(g.privileges[m[2]], p) {
+						g.privileges[m[2]] = append(g.privileges[m[2]], p)
+					}
+				}
+
+				return nil
+			},
+		},
+		{
+			Pattern: revokeRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				g.Record(req.SQL)
+
+				g.privileges[m[2]] = slices.DeleteFunc(g.privileges[m[2]], func(p string) bool {
+					return slices.Contains(strings.Split(m[1], ", "), p)
+				})
+
+				return nil
+			},
+		},
+		{
+			Pattern: regexp.MustCompile("information_schema.USERS"),
+			Query: func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				return []map[string]any{{"PLUGIN": "mysql_native_password"}}, nil
+			},
+		},
+		{
+			Pattern: testutil.Exactly("SHOW GRANTS FOR 'app'@'%'"),
+			Query: func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				rows := []map[string]any{{"Grants for app@%": "GRANT USAGE ON *.* TO 'app'@'%'"}}
+				for scope, privileges := range g.privileges {
+					if len(privileges) > 0 {
+						rows = append(rows, map[string]any{
+							"Grants for app@%": fmt.Sprintf("GRANT %s ON %s TO 'app'@'%%'", strings.Join(privileges, ", "), scope),
+						})
+					}
+				}
+
+				return rows, nil
+			},
+		},
+	})
+
+	return g
+}
+
+func (g *fakeGrants) grantOutsideTerraform(scope, privilege string) {
+	g.Do(func() {
+		g.privileges[scope] = append(g.privileges[scope], privilege)
+	})
+}
+
+func grantConfig(privileges string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_grant" "this" {
+  endpoint   = %q
+  password   = "secret"
+  user       = "app"
+  scope      = "my_app_db.*"
+  privileges = [%s]
+}
+`, testutil.TestWorkspaceEndpoint, privileges)
+}
+
+func TestCRUDSQLGrant(t *testing.T) {
+	g := newFakeGrants(t)
+	t.Setenv(config.EnvSQLUserPassword, "secret") // The imported grant reads the admin password from the environment.
+
+	testutil.UnitTest(t, g.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: grantConfig(`"SELECT", "INSERT"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_grant.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/app@%/my_app_db.*"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_grant.this", "privileges.#", "2"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_grant.this", "with_grant_option", "false"),
+				),
+			},
+			{
+				ResourceName:            "singlestoredb_sql_grant.this",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/app@%/my_app_db.*",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				// Only the difference is granted and revoked.
+				Config: grantConfig(`"SELECT", "UPDATE"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("singlestoredb_sql_grant.this", "privileges.*", "UPDATE"),
+				),
+			},
+			{
+				// A privilege granted outside of Terraform is revoked.
+				PreConfig: func() {
+					g.grantOutsideTerraform("`my_app_db`.*", "DELETE")
+				},
+				Config: grantConfig(`"SELECT", "UPDATE"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_grant.this", "privileges.#", "2"),
+				),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"GRANT INSERT, SELECT ON `my_app_db`.* TO 'app'@'%'",
+		"REVOKE INSERT ON `my_app_db`.* FROM 'app'@'%'",
+		"GRANT UPDATE ON `my_app_db`.* TO 'app'@'%'",
+		"REVOKE DELETE ON `my_app_db`.* FROM 'app'@'%'",
+		"REVOKE SELECT, UPDATE ON `my_app_db`.* FROM 'app'@'%'",
+	}, g.Executed())
+}
+
+func TestCRUDSQLGrantIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.SQLGrantResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_grant.app", "scope", "my_app_db.*"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_grant.app", "privileges.#", "3"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,303 @@
+package sqlusers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const MembershipResourceName = "sql_group_membership"
+
+var (
+	_ resource.Resource                = &membershipResource{}
+	_ resource.ResourceWithConfigure   = &membershipResource{}
+	_ resource.ResourceWithImportState = &membershipResource{}
+)
+
+type membershipResourceModel struct {
+	sql.ConnectionModel
+	ID    types.String `tfsdk:"id"`
+	Group types.String `tfsdk:"group"`
+	Role  types.String `tfsdk:"role"`
+	User  types.String `tfsdk:"user"`
+	Host  types.String `tfsdk:"host"`
+}
+
+type membershipResource struct {
+	sql.Connector
+}
+
+func NewMembershipResource() resource.Resource {
+	return &membershipResource{}
+}
+
+func (r *membershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, MembershipResourceName)
+}
+
+func (r *membershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Add a role or a user to a database group of a SingleStore Helios workspace via the Data API. " +
+			"A role added to a group (`GRANT ROLE ... TO ...`) passes its privileges to the group, " +
+			"and a user added to a group (`GRANT GROUP ... TO ...`) receives the privileges of all the roles of the group. " +
+			"A membership revoked outside of Terraform is granted again on the next apply.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the membership in the form `<endpoint>/<group>/role:<role>` or `<endpoint>/<group>/<user>@<host>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the group. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the role to add to the group. Exactly one of `role` and `user` must be set. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("role"), path.MatchRoot("user")),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the user to add to the group. Exactly one of `role` and `user` must be set. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("%"),
+				MarkdownDescription: "The host pattern of the user. Defaults to `%`. Ignored for roles. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		}),
+	}
+}
+
+func (r *membershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan membershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: grantMembershipStatement(plan)}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Group.ValueString(), memberName(plan)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *membershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state membershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	exists, err := membershipExists(ctx, client, state)
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if !exists {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+}
+
+func (r *membershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan membershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the credentials change in place.
+	plan.ConnectionModel = plan.ForState()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *membershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state membershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	exists, err := membershipExists(ctx, client, state)
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if !exists {
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: revokeMembershipStatement(state)}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *membershipResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *membershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	const format = "`<endpoint>/<group>/role:<role>` or `<endpoint>/<group>/<user>@<host>`"
+
+	names := sql.ImportConnection(ctx, req, resp, format, 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), names[0])...)
+
+	if role, ok := strings.CutPrefix(names[1], rolePrefix); ok && role != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), "%")...)
+
+		return
+	}
+
+	name, host, ok := splitUserHost(names[1])
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form %s, got %q.", format, req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+}
+
+// memberName returns the member part of the ID.
+func memberName(model membershipResourceModel) string {
+	if util.IsConfiguredString(model.Role) {
+		return rolePrefix + model.Role.ValueString()
+	}
+
+	return model.User.ValueString() + "@" + model.Host.ValueString()
+}
+
+func grantMembershipStatement(model membershipResourceModel) string {
+	group := sql.QuoteString(model.Group.ValueString())
+	if util.IsConfiguredString(model.Role) {
+		return fmt.Sprintf("GRANT ROLE %s TO %s", sql.QuoteString(model.Role.ValueString()), group)
+	}
+
+	return fmt.Sprintf("GRANT GROUP %s TO %s", group, membershipUser(model))
+}
+
+func revokeMembershipStatement(model membershipResourceModel) string {
+	group := sql.QuoteString(model.Group.ValueString())
+	if util.IsConfiguredString(model.Role) {
+		return fmt.Sprintf("REVOKE ROLE %s FROM %s", sql.QuoteString(model.Role.ValueString()), group)
+	}
+
+	return fmt.Sprintf("REVOKE GROUP %s FROM %s", group, membershipUser(model))
+}
+
+func membershipUser(model membershipResourceModel) string {
+	return sql.QuoteString(model.User.ValueString()) + "@" + sql.QuoteString(model.Host.ValueString())
+}
+
+// membershipExists reports whether the role or the user is a member of the group.
+// The group and the member are checked first, since listing the members of a missing one fails.
+func membershipExists(ctx context.Context, client *sql.Client, model membershipResourceModel) (bool, error) {
+	group := model.Group.ValueString()
+
+	exists, err := principalExists(ctx, client, groupKind, group)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	if util.IsConfiguredString(model.Role) {
+		role := model.Role.ValueString()
+
+		exists, err := principalExists(ctx, client, roleKind, role)
+		if err != nil || !exists {
+			return false, err
+		}
+
+		return listContains(ctx, client, "SHOW ROLES FOR GROUP "+sql.QuoteString(group), role)
+	}
+
+	info, err := readUser(ctx, client, model.User.ValueString(), model.Host.ValueString())
+	if err != nil || info == nil {
+		return false, err
+	}
+
+	return listContains(ctx, client, "SHOW GROUPS FOR USER "+membershipUser(model), group)
+}
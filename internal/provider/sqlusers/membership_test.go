@@ -0,0 +1,228 @@
+package sqlusers_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	principalStatementRegexp  = regexp.MustCompile(`^(CREATE|DROP) (ROLE|GROUP) '([^']+)'$`)
+	membershipStatementRegexp = regexp.MustCompile(`^(GRANT|REVOKE) (ROLE|GROUP) '([^']+)' (?:TO|FROM) '([^']+)'(@'%')?$`)
+)
+
+// fakeRBAC keeps the roles, the groups, and the memberships of a mock workspace.
+// The user 'app'@'%' always exists.
+type fakeRBAC struct {
+	*testutil.FakeWorkspace
+	roles      []string
+	groups     []string
+	groupRoles map[string][]string
+	userGroups []string
+}
+
+func newFakeRBAC(t *testing.T) *fakeRBAC {
+	t.Helper()
+
+	w := &fakeRBAC{groupRoles: map[string][]string{}}
+
+	// names lists the values as the rows of SHOW ROLES and SHOW GROUPS.
+	names := func(values func() []string) func(testutil.DataAPIRequest, []string) ([]map[string]any, error) {
+		return func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+			rows := []map[string]any{}
+			for _, value := range values() {
+				rows = append(rows, map[string]any{"Name": value})
+			}
+
+			return rows, nil
+		}
+	}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: principalStatementRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				w.Record(req.SQL)
+
+				switch {
+				case m[1] == "CREATE" && m[2] == "ROLE":
+					w.roles = append(w.roles, m[3])
+				case m[1] == "CREATE":
+					w.groups = append(w.groups, m[3])
+				case m[2] == "ROLE":
+					w.roles = slices.DeleteFunc(w.roles, func(s string) bool { return s == m[3] })
+				default:
+					w.groups = slices.DeleteFunc(w.groups, func(s string) bool { return s == m[3] })
+				}
+
+				return nil
+			},
+		},
+		{
+			Pattern: membershipStatementRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				w.Record(req.SQL)
+
+				switch {
+				case m[1] == "GRANT" && m[2] == "ROLE":
+					w.groupRoles[m[4]] = append(w.groupRoles[m[4]], m[3])
+				case m[1] == "GRANT":
+					w.userGroups = append(w.userGroups, m[3])
+				case m[2] == "ROLE":
+					w.groupRoles[m[4]] = slices.DeleteFunc(w.groupRoles[m[4]], func(s string) bool { return s == m[3] })
+				default:
+					w.userGroups = slices.DeleteFunc(w.userGroups, func(s string) bool { return s == m[3] })
+				}
+
+				return nil
+			},
+		},
+		{Pattern: testutil.Exactly("SHOW ROLES"), Query: names(func() []string { return w.roles })},
+		{Pattern: testutil.Exactly("SHOW GROUPS"), Query: names(func() []string { return w.groups })},
+		{Pattern: testutil.Exactly("SHOW ROLES FOR GROUP 'analysts'"), Query: names(func() []string { return w.groupRoles["analysts"] })},
+		{Pattern: testutil.Exactly("SHOW GROUPS FOR USER 'app'@'%'"), Query: names(func() []string { return w.userGroups })},
+		{
+			Pattern: regexp.MustCompile("information_schema.USERS"),
+			Query: func(_ testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				return []map[string]any{{"PLUGIN": "mysql_native_password"}}, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (w *fakeRBAC) revokeRoleOutsideTerraform(role, group string) {
+	w.Do(func() {
+		w.groupRoles[group] = slices.DeleteFunc(w.groupRoles[group], func(s string) bool { return s == role })
+	})
+}
+
+func rbacConfig() string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+locals {
+  endpoint = %q
+}
+
+resource "singlestoredb_sql_role" "reader" {
+  endpoint = local.endpoint
+  password = "secret"
+  name     = "reader"
+}
+
+resource "singlestoredb_sql_group" "analysts" {
+  endpoint = local.endpoint
+  password = "secret"
+  name     = "analysts"
+}
+
+resource "singlestoredb_sql_group_membership" "reader" {
+  endpoint = local.endpoint
+  password = "secret"
+  group    = singlestoredb_sql_group.analysts.name
+  role     = singlestoredb_sql_role.reader.name
+}
+
+resource "singlestoredb_sql_group_membership" "app" {
+  endpoint = local.endpoint
+  password = "secret"
+  group    = singlestoredb_sql_group.analysts.name
+  user     = "app"
+}
+`, testutil.TestWorkspaceEndpoint)
+}
+
+func TestCRUDSQLRolesAndGroups(t *testing.T) {
+	w := newFakeRBAC(t)
+	t.Setenv(config.EnvSQLUserPassword, "secret") // The imported resources read the admin password from the environment.
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: rbacConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_role.reader", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/reader"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_group.analysts", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/analysts"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_group_membership.reader", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/analysts/role:reader"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_group_membership.app", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/analysts/app@%"),
+				),
+			},
+			{
+				ResourceName:            "singlestoredb_sql_group.analysts",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/analysts",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				ResourceName:            "singlestoredb_sql_group_membership.reader",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/analysts/role:reader",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				ResourceName:            "singlestoredb_sql_group_membership.app",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/analysts/app@%",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				// The role revoked outside of Terraform is granted again.
+				PreConfig: func() {
+					w.revokeRoleOutsideTerraform("reader", "analysts")
+				},
+				Config: rbacConfig(),
+			},
+		},
+	})
+
+	statements := w.Executed()
+	require.Equal(t, 2, countOf(statements, "GRANT ROLE 'reader' TO 'analysts'"), "the revoked role should be granted again")
+	require.Contains(t, statements, "REVOKE GROUP 'analysts' FROM 'app'@'%'")
+	require.Contains(t, statements, "DROP ROLE 'reader'")
+	require.Contains(t, statements, "DROP GROUP 'analysts'")
+}
+
+func countOf(values []string, value string) int {
+	count := 0
+	for _, v := range values {
+		if v == value {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestSQLRolesAndGroupsIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.SQLGroupMembershipResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_group_membership.reader", "role", "reader"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_group_membership.app", "user", "app"),
+				),
+			},
+		},
+	})
+}
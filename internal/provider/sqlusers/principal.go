@@ -0,0 +1,257 @@
+package sqlusers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	RoleResourceName  = "sql_role"
+	GroupResourceName = "sql_group"
+)
+
+// principalKind describes the roles and the groups, which share the same lifecycle.
+type principalKind struct {
+	// ResourceName is the name of the resource without the provider prefix.
+	ResourceName string
+	// Keyword is the object type in the statements, e.g., CREATE ROLE.
+	Keyword string
+	// Description is the paragraph that explains the kind in the schema.
+	Description string
+}
+
+var (
+	roleKind = principalKind{
+		ResourceName: RoleResourceName,
+		Keyword:      "ROLE",
+		Description: "A role is a named set of privileges. Privileges are granted to the role with `singlestoredb_sql_grant`, " +
+			"and the role is granted to groups with `singlestoredb_sql_group_membership`.",
+	}
+	groupKind = principalKind{
+		ResourceName: GroupResourceName,
+		Keyword:      "GROUP",
+		Description: "A group is a set of users that receives the privileges of the roles granted to it. " +
+			"Roles and users are added to the group with `singlestoredb_sql_group_membership`.",
+	}
+)
+
+var (
+	_ resource.Resource                = &principalResource{}
+	_ resource.ResourceWithConfigure   = &principalResource{}
+	_ resource.ResourceWithImportState = &principalResource{}
+)
+
+type principalResourceModel struct {
+	sql.ConnectionModel
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type principalResource struct {
+	sql.Connector
+	kind principalKind
+}
+
+func NewRoleResource() resource.Resource {
+	return &principalResource{kind: roleKind}
+}
+
+func NewGroupResource() resource.Resource {
+	return &principalResource{kind: groupKind}
+}
+
+func (r *principalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, r.kind.ResourceName)
+}
+
+func (r *principalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	kind := strings.ToLower(r.kind.Keyword)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manage a database %s of a SingleStore Helios workspace via the Data API. %s "+
+			"A %s dropped outside of Terraform is created again on the next apply.",
+			kind, r.kind.Description, kind,
+		),
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("The identifier of the %s in the form `<endpoint>/<name>`.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("The name of the %s. Changing this value forces replacement.", kind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		}),
+	}
+}
+
+func (r *principalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan principalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	statement := fmt.Sprintf("CREATE %s %s", r.kind.Keyword, sql.QuoteString(plan.Name.ValueString()))
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *principalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state principalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	exists, err := principalExists(ctx, client, r.kind, state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if !exists {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+}
+
+func (r *principalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan principalResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the credentials change in place.
+	plan.ConnectionModel = plan.ForState()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *principalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state principalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	exists, err := principalExists(ctx, client, r.kind, state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if !exists {
+		return
+	}
+
+	statement := fmt.Sprintf("DROP %s %s", r.kind.Keyword, sql.QuoteString(state.Name.ValueString()))
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *principalResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *principalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := sql.ImportConnection(ctx, req, resp, "`<endpoint>/<name>`", 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), names[0])...)
+}
+
+// principalExists reports whether the role or the group exists.
+func principalExists(ctx context.Context, client *sql.Client, kind principalKind, name string) (bool, error) {
+	return listContains(ctx, client, fmt.Sprintf("SHOW %sS", kind.Keyword), name)
+}
+
+// listContains reports whether the first column of the result of a SHOW statement, which holds the names
+// of the roles or groups, contains the name. SHOW ROLES and SHOW GROUPS are used rather than information_schema,
+// since they are the documented way to list roles, groups, and their members, including the members of a grantee.
+func listContains(ctx context.Context, client *sql.Client, statement, name string) (bool, error) {
+	resp, err := client.QueryRows(ctx, sql.ExecRequest{SQL: statement})
+	if err != nil {
+		return false, err
+	}
+
+	if len(resp.Results) == 0 || len(resp.Results[0].Columns) == 0 {
+		return false, nil
+	}
+
+	rows, err := sql.StringifyRows(resp.Results[0].Rows)
+	if err != nil {
+		return false, err
+	}
+
+	column := resp.Results[0].Columns[0].Name
+	for _, row := range rows {
+		if row[column] == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,182 @@
+package sqlusers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUserStatement(t *testing.T) {
+	model := userResourceModel{
+		Name:                types.StringValue("app"),
+		Host:                types.StringValue("10.0.%"),
+		AuthenticationJWT:   types.BoolValue(false),
+		ResourcePool:        types.StringNull(),
+		FailedLoginAttempts: types.Int64Null(),
+		PasswordLockTime:    types.Int64Null(),
+	}
+	require.Equal(t, sql.ExecRequest{
+		SQL:  "CREATE USER 'app'@'10.0.%' IDENTIFIED BY ?",
+		Args: []any{"secret"},
+	}, createUserStatement(model, types.StringValue("secret")))
+
+	model.ResourcePool = types.StringValue("etl")
+	model.FailedLoginAttempts = types.Int64Value(5)
+	model.PasswordLockTime = types.Int64Value(600)
+	require.Equal(t,
+		"CREATE USER 'app'@'10.0.%' IDENTIFIED BY ? WITH DEFAULT RESOURCE POOL = `etl` FAILED_LOGIN_ATTEMPTS = 5 PASSWORD_LOCK_TIME = 600",
+		createUserStatement(model, types.StringValue("secret")).SQL,
+	)
+
+	model = userResourceModel{
+		Name:              types.StringValue("o'brien@example.com"),
+		Host:              types.StringValue("%"),
+		AuthenticationJWT: types.BoolValue(true),
+	}
+	require.Equal(t, sql.ExecRequest{
+		SQL: `CREATE USER 'o\'brien@example.com'@'%' IDENTIFIED WITH authentication_jwt`,
+	}, createUserStatement(model, types.StringNull()))
+}
+
+func TestAlterUserStatements(t *testing.T) {
+	state := userResourceModel{
+		Name:                types.StringValue("app"),
+		Host:                types.StringValue("%"),
+		UserPasswordVersion: types.Int64Value(1),
+		AuthenticationJWT:   types.BoolValue(false),
+		ResourcePool:        types.StringValue("etl"),
+		FailedLoginAttempts: types.Int64Value(5),
+		PasswordLockTime:    types.Int64Value(600),
+	}
+	require.Empty(t, alterUserStatements(state, state, types.StringValue("new-secret")), "the password is only set when its version changes")
+
+	plan := state
+	plan.UserPasswordVersion = types.Int64Value(2)
+	plan.ResourcePool = types.StringNull()
+	plan.FailedLoginAttempts = types.Int64Null()
+	plan.PasswordLockTime = types.Int64Null()
+	require.Equal(t, []sql.ExecRequest{
+		{SQL: "ALTER USER 'app'@'%' IDENTIFIED BY ?", Args: []any{"new-secret"}},
+		{SQL: "ALTER USER 'app'@'%' SET DEFAULT RESOURCE POOL = `default_pool`"},
+		{SQL: "ALTER USER 'app'@'%' SET FAILED_LOGIN_ATTEMPTS = 0 PASSWORD_LOCK_TIME = 0"},
+	}, alterUserStatements(plan, state, types.StringValue("new-secret")))
+}
+
+func TestSplitUserHost(t *testing.T) {
+	name, host, ok := splitUserHost("analyst@example.com@10.0.%")
+	require.True(t, ok)
+	require.Equal(t, "analyst@example.com", name)
+	require.Equal(t, "10.0.%", host)
+
+	for _, s := range []string{"app", "@%", "app@"} {
+		_, _, ok := splitUserHost(s)
+		require.False(t, ok, s)
+	}
+}
+
+func TestToUserResourceModel(t *testing.T) {
+	model := userResourceModel{
+		ResourcePool:        types.StringNull(),
+		FailedLoginAttempts: types.Int64Value(5),
+		PasswordLockTime:    types.Int64Value(600),
+	}
+
+	result := toUserResourceModel(model, userInfo{Plugin: "mysql_native_password", ResourcePool: defaultResourcePool})
+	require.False(t, result.AuthenticationJWT.ValueBool())
+	require.True(t, result.ResourcePool.IsNull(), "the implicit default pool should not show up as drift")
+	require.True(t, result.FailedLoginAttempts.IsNull(), "a lockout policy removed outside of Terraform should show up as drift")
+	require.True(t, result.PasswordLockTime.IsNull())
+
+	result = toUserResourceModel(model, userInfo{Plugin: jwtPlugin, ResourcePool: "etl", FailedLoginAttempts: 3, PasswordLockTime: 60})
+	require.True(t, result.AuthenticationJWT.ValueBool())
+	require.Equal(t, "etl", result.ResourcePool.ValueString())
+	require.Equal(t, int64(3), result.FailedLoginAttempts.ValueInt64())
+	require.Equal(t, int64(60), result.PasswordLockTime.ValueInt64())
+}
+
+func TestParseGrants(t *testing.T) {
+	statements := []string{
+		"GRANT USAGE ON *.* TO 'app'@'%'",
+		"GRANT SELECT, INSERT ON `my_app_db`.* TO 'app'@'%' WITH GRANT OPTION",
+		"GRANT UPDATE ON `my_app_db`.* TO 'app'@'%'",
+		"GRANT DELETE ON `my_app_db`.`orders` TO 'app'@'%'",
+		"GRANT SELECT ON `my``db`.* TO 'app'@'%'",
+		"GRANT SELECT (`id`, `total`), UPDATE (`status`), INSERT ON `my_app_db`.`order items` TO 'app'@'%'",
+		"GRANT SELECT ON `my app, db`.`orders` TO 'app'@'%'",
+	}
+
+	require.Equal(t, grantInfo{Privileges: []string{"INSERT", "SELECT", "UPDATE"}, WithGrantOption: true}, parseGrants(statements, "my_app_db.*"))
+	require.Equal(t, grantInfo{Privileges: []string{"DELETE"}}, parseGrants(statements, "my_app_db.orders"))
+	require.Equal(t, grantInfo{Privileges: []string{"SELECT"}}, parseGrants(statements, "my`db.*"))
+	require.Equal(t, grantInfo{}, parseGrants(statements, "*.*"), "USAGE means no privileges")
+	require.Equal(t, grantInfo{Privileges: []string{"INSERT"}}, parseGrants(statements, "my_app_db.order items"), "column privileges are not managed")
+	require.Equal(t, grantInfo{Privileges: []string{"SELECT"}}, parseGrants(statements, "my app, db.orders"))
+}
+
+func TestGrantStatements(t *testing.T) {
+	model := grantResourceModel{
+		User:  types.StringValue("app"),
+		Host:  types.StringValue("%"),
+		Role:  types.StringNull(),
+		Scope: types.StringValue("my_app_db.*"),
+	}
+
+	require.Equal(t, []string{
+		"GRANT INSERT, SELECT ON `my_app_db`.* TO 'app'@'%'",
+	}, grantStatements(model, grantInfo{Privileges: []string{"INSERT", "SELECT"}}, grantInfo{}))
+
+	require.Empty(t, grantStatements(model, grantInfo{Privileges: []string{"SELECT"}}, grantInfo{Privileges: []string{"SELECT"}}))
+
+	require.Equal(t, []string{
+		"REVOKE DELETE ON `my_app_db`.* FROM 'app'@'%'",
+		"GRANT UPDATE ON `my_app_db`.* TO 'app'@'%'",
+	}, grantStatements(model,
+		grantInfo{Privileges: []string{"SELECT", "UPDATE"}},
+		grantInfo{Privileges: []string{"DELETE", "SELECT"}},
+	))
+
+	model.User = types.StringNull()
+	model.Role = types.StringValue("analyst")
+	model.Scope = types.StringValue("*.*")
+
+	require.Equal(t, []string{
+		"GRANT SELECT ON *.* TO ROLE 'analyst' WITH GRANT OPTION",
+	}, grantStatements(model, grantInfo{Privileges: []string{"SELECT"}, WithGrantOption: true}, grantInfo{Privileges: []string{"SELECT"}}))
+
+	require.Equal(t, []string{
+		"REVOKE SELECT ON *.* FROM ROLE 'analyst'",
+		"REVOKE GRANT OPTION ON *.* FROM ROLE 'analyst'",
+	}, grantStatements(model, grantInfo{}, grantInfo{Privileges: []string{"SELECT"}, WithGrantOption: true}))
+}
+
+func TestScopeQuoting(t *testing.T) {
+	for scope, quoted := range map[string]string{
+		"*.*":              "*.*",
+		"my_app_db.*":      "`my_app_db`.*",
+		"my_app_db.orders": "`my_app_db`.`orders`",
+		"my`db.*":          "`my``db`.*",
+	} {
+		require.Equal(t, quoted, quoteScope(scope))
+		require.Equal(t, scope, unquoteScope(quoted))
+	}
+}
+
+func TestMembershipStatements(t *testing.T) {
+	model := membershipResourceModel{
+		Group: types.StringValue("analysts"),
+		Role:  types.StringValue("reader"),
+		User:  types.StringNull(),
+		Host:  types.StringValue("%"),
+	}
+	require.Equal(t, "GRANT ROLE 'reader' TO 'analysts'", grantMembershipStatement(model))
+	require.Equal(t, "REVOKE ROLE 'reader' FROM 'analysts'", revokeMembershipStatement(model))
+	require.Equal(t, "role:reader", memberName(model))
+
+	model.Role = types.StringNull()
+	model.User = types.StringValue("app")
+	require.Equal(t, "GRANT GROUP 'analysts' TO 'app'@'%'", grantMembershipStatement(model))
+	require.Equal(t, "REVOKE GROUP 'analysts' FROM 'app'@'%'", revokeMembershipStatement(model))
+	require.Equal(t, "app@%", memberName(model))
+}
@@ -0,0 +1,512 @@
+package sqlusers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	UserResourceName = "sql_user"
+
+	// jwtPlugin is the authentication plugin of the users that log in with JWTs.
+	jwtPlugin = "authentication_jwt"
+	// defaultResourcePool is the resource pool of the users without a default resource pool.
+	defaultResourcePool = "default_pool"
+)
+
+var (
+	_ resource.Resource                   = &userResource{}
+	_ resource.ResourceWithConfigure      = &userResource{}
+	_ resource.ResourceWithImportState    = &userResource{}
+	_ resource.ResourceWithValidateConfig = &userResource{}
+)
+
+type userResourceModel struct {
+	sql.ConnectionModel
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Host                types.String `tfsdk:"host"`
+	UserPassword        types.String `tfsdk:"user_password"`
+	UserPasswordVersion types.Int64  `tfsdk:"user_password_version"`
+	AuthenticationJWT   types.Bool   `tfsdk:"authentication_jwt"`
+	ResourcePool        types.String `tfsdk:"resource_pool"`
+	FailedLoginAttempts types.Int64  `tfsdk:"failed_login_attempts"`
+	PasswordLockTime    types.Int64  `tfsdk:"password_lock_time"`
+}
+
+// userInfo is a row of information_schema.USERS.
+type userInfo struct {
+	Plugin              string
+	ResourcePool        string
+	FailedLoginAttempts int64
+	PasswordLockTime    int64
+}
+
+type userResource struct {
+	sql.Connector
+}
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, UserResourceName)
+}
+
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a database user of a SingleStore Helios workspace via the Data API. " +
+			"Users authenticate with a password or with JWTs. " +
+			"Changes made outside of Terraform are detected through `information_schema.USERS`.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the user in the form `<endpoint>/<name>@<host>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the user. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("%"),
+				MarkdownDescription: "The host pattern the user connects from, e.g., `10.0.%`. Defaults to `%`, which matches any host. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "The password of the user. The password is write-only: it is sent to the workspace but not stored in the plan or the state, which requires Terraform 1.11 or later. " +
+					"Since neither Terraform nor the workspace report the password, a new value is only set when `user_password_version` changes, and changes made outside of Terraform are not detected. " +
+					"Required unless `authentication_jwt` is `true`, with which it conflicts.",
+			},
+			"user_password_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The version of `user_password`. Changing this value sets the current `user_password` in place. Requires `user_password`.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("user_password")),
+				},
+			},
+			"authentication_jwt": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the user authenticates with JWTs (`IDENTIFIED WITH authentication_jwt`) instead of a password. Defaults to `false`. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_pool": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The default resource pool of the user's queries, e.g., `singlestoredb_resource_pool.<name>.name`. When unset, the user runs in the default resource pool of the workspace.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"failed_login_attempts": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of consecutive failed logins after which the user is locked for `password_lock_time` seconds. Requires `password_lock_time`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("password_lock_time")),
+				},
+			},
+			"password_lock_time": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The number of seconds the user is locked after `failed_login_attempts` consecutive failed logins. Requires `failed_login_attempts`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("failed_login_attempts")),
+				},
+			},
+		}),
+	}
+}
+
+func (r *userResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var conf userResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if conf.AuthenticationJWT.ValueBool() && !conf.UserPassword.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_password"),
+			"Invalid configuration",
+			"user_password cannot be set for users that authenticate with JWTs (authentication_jwt = true).",
+		)
+	}
+
+	// A user without a password could log in with any password.
+	if !conf.AuthenticationJWT.IsUnknown() && !conf.AuthenticationJWT.ValueBool() && conf.UserPassword.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_password"),
+			"Invalid configuration",
+			"user_password is required for users that authenticate with a password (authentication_jwt = false).",
+		)
+	}
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, diags := configuredPassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, createUserStatement(plan, password)); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	info, err := readUser(ctx, client, plan.Name.ValueString(), plan.Host.ValueString())
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if info == nil {
+		resp.Diagnostics.AddError(
+			"User not found after creation",
+			fmt.Sprintf("The user %s is not listed in information_schema.USERS after it was created. %s",
+				userHost(plan), config.CreateProviderIssueErrorDetail,
+			),
+		)
+
+		return
+	}
+
+	result := toUserResourceModel(plan, *info)
+	result.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Name.ValueString()+"@"+plan.Host.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	info, err := readUser(ctx, client, state.Name.ValueString(), state.Host.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if info == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	result := toUserResourceModel(state, *info)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, diags := configuredPassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	for _, statement := range alterUserStatements(plan, state, password) {
+		if _, err := client.Exec(ctx, statement); err != nil {
+			serr := sql.DiagnosticFromError(err)
+			resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+			return
+		}
+	}
+
+	info, err := readUser(ctx, client, plan.Name.ValueString(), plan.Host.ValueString())
+	if err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if info == nil {
+		resp.Diagnostics.AddError(
+			"User not found",
+			fmt.Sprintf("The user %s is not listed in information_schema.USERS after it was updated.", userHost(plan)),
+		)
+
+		return
+	}
+
+	result := toUserResourceModel(plan, *info)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: "DROP USER IF EXISTS " + userHost(state)}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := sql.ImportConnection(ctx, req, resp, "`<endpoint>/<name>@<host>`", 1)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name, host, ok := splitUserHost(names[0])
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form `<endpoint>/<name>@<host>`, got %q.", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+}
+
+// userHost returns the quoted 'name'@'host' of the user.
+func userHost(model userResourceModel) string {
+	return sql.QuoteString(model.Name.ValueString()) + "@" + sql.QuoteString(model.Host.ValueString())
+}
+
+// splitUserHost splits name@host at the last @, since host patterns never contain @.
+func splitUserHost(s string) (string, string, bool) {
+	i := strings.LastIndex(s, "@")
+	if i <= 0 || i == len(s)-1 {
+		return "", "", false
+	}
+
+	return s[:i], s[i+1:], true
+}
+
+// configuredPassword returns user_password, which is write-only and thus only available in the configuration.
+func configuredPassword(ctx context.Context, conf tfsdk.Config) (types.String, diag.Diagnostics) {
+	var password types.String
+	diags := conf.GetAttribute(ctx, path.Root("user_password"), &password)
+
+	return password, diags
+}
+
+func createUserStatement(plan userResourceModel, password types.String) sql.ExecRequest {
+	req := sql.ExecRequest{SQL: "CREATE USER " + userHost(plan)}
+
+	switch {
+	case plan.AuthenticationJWT.ValueBool():
+		req.SQL += " IDENTIFIED WITH " + jwtPlugin
+	case util.IsConfiguredString(password):
+		req.SQL += " IDENTIFIED BY ?"
+		req.Args = []any{password.ValueString()}
+	}
+
+	var options []string
+	if util.IsConfiguredString(plan.ResourcePool) {
+		options = append(options, "DEFAULT RESOURCE POOL = "+sql.QuoteIdentifier(plan.ResourcePool.ValueString()))
+	}
+
+	if attempts := util.MaybeInt64(plan.FailedLoginAttempts); attempts != nil {
+		options = append(options, failedLoginOptions(*attempts, plan.PasswordLockTime.ValueInt64()))
+	}
+
+	if len(options) > 0 {
+		req.SQL += " WITH " + strings.Join(options, " ")
+	}
+
+	return req
+}
+
+func alterUserStatements(plan, state userResourceModel, password types.String) []sql.ExecRequest {
+	var result []sql.ExecRequest
+
+	if !plan.UserPasswordVersion.Equal(state.UserPasswordVersion) && util.IsConfiguredString(password) && !plan.AuthenticationJWT.ValueBool() {
+		result = append(result, sql.ExecRequest{
+			SQL:  "ALTER USER " + userHost(plan) + " IDENTIFIED BY ?",
+			Args: []any{password.ValueString()},
+		})
+	}
+
+	if !plan.ResourcePool.Equal(state.ResourcePool) {
+		pool := defaultResourcePool
+		if util.IsConfiguredString(plan.ResourcePool) {
+			pool = plan.ResourcePool.ValueString()
+		}
+
+		result = append(result, sql.ExecRequest{
+			SQL: "ALTER USER " + userHost(plan) + " SET DEFAULT RESOURCE POOL = " + sql.QuoteIdentifier(pool),
+		})
+	}
+
+	if !plan.FailedLoginAttempts.Equal(state.FailedLoginAttempts) || !plan.PasswordLockTime.Equal(state.PasswordLockTime) {
+		// Zero attempts disable locking the user.
+		result = append(result, sql.ExecRequest{
+			SQL: "ALTER USER " + userHost(plan) + " SET " +
+				failedLoginOptions(plan.FailedLoginAttempts.ValueInt64(), plan.PasswordLockTime.ValueInt64()),
+		})
+	}
+
+	return result
+}
+
+func failedLoginOptions(attempts, lockTime int64) string {
+	return fmt.Sprintf("FAILED_LOGIN_ATTEMPTS = %d PASSWORD_LOCK_TIME = %d", attempts, lockTime)
+}
+
+// readUser returns nil if the user does not exist.
+func readUser(ctx context.Context, client *sql.Client, name, host string) (*userInfo, error) {
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL: "SELECT PLUGIN, DEFAULT_RESOURCE_POOL, FAILED_LOGIN_ATTEMPTS, PASSWORD_LOCK_TIME " +
+			"FROM information_schema.USERS WHERE USER = ? AND HOST = ?",
+		Args: []any{name, host},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	info := userInfo{
+		Plugin:       rows[0]["PLUGIN"],
+		ResourcePool: rows[0]["DEFAULT_RESOURCE_POOL"],
+	}
+
+	if info.FailedLoginAttempts, err = parseOptionalInt64(rows[0]["FAILED_LOGIN_ATTEMPTS"]); err != nil {
+		return nil, fmt.Errorf("invalid FAILED_LOGIN_ATTEMPTS of the user %s@%s: %w", name, host, err)
+	}
+
+	if info.PasswordLockTime, err = parseOptionalInt64(rows[0]["PASSWORD_LOCK_TIME"]); err != nil {
+		return nil, fmt.Errorf("invalid PASSWORD_LOCK_TIME of the user %s@%s: %w", name, host, err)
+	}
+
+	return &info, nil
+}
+
+func parseOptionalInt64(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func toUserResourceModel(model userResourceModel, info userInfo) userResourceModel {
+	model.ConnectionModel = model.ForState()
+	model.AuthenticationJWT = types.BoolValue(info.Plugin == jwtPlugin)
+
+	switch {
+	case info.ResourcePool == "":
+		model.ResourcePool = types.StringNull()
+	case info.ResourcePool == defaultResourcePool && model.ResourcePool.IsNull():
+		// Keep the implicit default.
+	default:
+		model.ResourcePool = types.StringValue(info.ResourcePool)
+	}
+
+	if info.FailedLoginAttempts == 0 {
+		model.FailedLoginAttempts = types.Int64Null()
+		model.PasswordLockTime = types.Int64Null()
+	} else {
+		model.FailedLoginAttempts = types.Int64Value(info.FailedLoginAttempts)
+		model.PasswordLockTime = types.Int64Value(info.PasswordLockTime)
+	}
+
+	return model
+}
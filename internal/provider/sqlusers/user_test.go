@@ -0,0 +1,282 @@
+package sqlusers_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	createUserRegexp = regexp.MustCompile(`^CREATE USER '([^']+)'@'([^']+)'(.*)$`)
+	alterUserRegexp  = regexp.MustCompile(`^ALTER USER '([^']+)'@'([^']+)' (.*)$`)
+	dropUserRegexp   = regexp.MustCompile(`^DROP USER IF EXISTS '([^']+)'@'([^']+)'$`)
+	usersQueryRegexp = regexp.MustCompile(regexp.QuoteMeta("FROM information_schema.USERS WHERE USER = ? AND HOST = ?"))
+	poolRegexp       = regexp.MustCompile("DEFAULT RESOURCE POOL = `([^`]+)`")
+	lockoutRegexp    = regexp.MustCompile(`FAILED_LOGIN_ATTEMPTS = (\d+) PASSWORD_LOCK_TIME = (\d+)`)
+)
+
+type fakeUser struct {
+	plugin   string
+	password string
+	pool     string
+	attempts string
+	lockTime string
+}
+
+// fakeWorkspace keeps the users of a mock workspace.
+type fakeWorkspace struct {
+	*testutil.FakeWorkspace
+	users map[string]*fakeUser
+}
+
+func newFakeWorkspace(t *testing.T) *fakeWorkspace {
+	t.Helper()
+
+	w := &fakeWorkspace{users: map[string]*fakeUser{}}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: createUserRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				u := &fakeUser{plugin: "mysql_native_password", pool: "default_pool", attempts: "0", lockTime: "0"}
+				if strings.Contains(m[3], "authentication_jwt") {
+					u.plugin = "authentication_jwt"
+				}
+
+				if len(req.Args) > 0 {
+					u.password = req.Args[0].(string)
+				}
+
+				u.apply(m[3])
+				w.users[m[1]+"@"+m[2]] = u
+
+				return nil
+			},
+		},
+		{
+			Pattern: alterUserRegexp,
+			Exec: func(req testutil.DataAPIRequest, m []string) error {
+				u, ok := w.users[m[1]+"@"+m[2]]
+				if !ok {
+					return fmt.Errorf("User '%s'@'%s' does not exist", m[1], m[2])
+				}
+
+				if strings.HasPrefix(m[3], "IDENTIFIED BY") {
+					u.password = req.Args[0].(string)
+				}
+
+				u.apply(m[3])
+
+				return nil
+			},
+		},
+		{
+			Pattern: dropUserRegexp,
+			Exec: func(_ testutil.DataAPIRequest, m []string) error {
+				delete(w.users, m[1]+"@"+m[2])
+
+				return nil
+			},
+		},
+		{
+			Pattern: usersQueryRegexp,
+			Query: func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+				u, ok := w.users[req.Args[0].(string)+"@"+req.Args[1].(string)]
+				if !ok {
+					return nil, nil
+				}
+
+				return []map[string]any{{
+					"PLUGIN":                u.plugin,
+					"DEFAULT_RESOURCE_POOL": u.pool,
+					"FAILED_LOGIN_ATTEMPTS": u.attempts,
+					"PASSWORD_LOCK_TIME":    u.lockTime,
+				}}, nil
+			},
+		},
+	})
+
+	return w
+}
+
+func (u *fakeUser) apply(options string) {
+	if m := poolRegexp.FindStringSubmatch(options); m != nil {
+		u.pool = m[1]
+	}
+
+	if m := lockoutRegexp.FindStringSubmatch(options); m != nil {
+		u.attempts, u.lockTime = m[1], m[2]
+	}
+}
+
+func (w *fakeWorkspace) user(key string) *fakeUser {
+	var result *fakeUser
+	w.Do(func() {
+		if u, ok := w.users[key]; ok {
+			copied := *u
+			result = &copied
+		}
+	})
+
+	return result
+}
+
+func (w *fakeWorkspace) alterOutsideTerraform(key string, pool string) {
+	w.Do(func() {
+		w.users[key].pool = pool
+	})
+}
+
+func userConfig(password, extra string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_user" "this" {
+  endpoint      = %q
+  password      = "secret"
+  name          = "app"
+  host          = "10.0.%%"
+  user_password = %q
+  %s
+}
+`, testutil.TestWorkspaceEndpoint, password, extra)
+}
+
+func TestCRUDSQLUser(t *testing.T) {
+	w := newFakeWorkspace(t)
+	t.Setenv(config.EnvSQLUserPassword, "secret") // The imported user reads the admin password from the environment.
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: userConfig("first", "user_password_version = 1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/app@10.0.%"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.this", "authentication_jwt", "false"),
+					resource.TestCheckNoResourceAttr("singlestoredb_sql_user.this", "user_password"),
+					resource.TestCheckNoResourceAttr("singlestoredb_sql_user.this", "resource_pool"),
+					resource.TestCheckNoResourceAttr("singlestoredb_sql_user.this", "failed_login_attempts"),
+				),
+			},
+			{
+				ResourceName:            "singlestoredb_sql_user.this",
+				ImportState:             true,
+				ImportStateId:           testutil.TestWorkspaceEndpoint + "/app@10.0.%",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "user_password_version"},
+			},
+			{
+				// The write-only password is not compared, so it is only set when its version changes.
+				Config:   userConfig("second", "user_password_version = 1"),
+				PlanOnly: true,
+			},
+			{
+				Config: userConfig("second", `
+  user_password_version = 2
+  resource_pool         = "etl"
+  failed_login_attempts = 5
+  password_lock_time    = 600
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.this", "resource_pool", "etl"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.this", "failed_login_attempts", "5"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.this", "password_lock_time", "600"),
+					func(_ *terraform.State) error {
+						if u := w.user("app@10.0.%"); u == nil || u.password != "second" {
+							return fmt.Errorf("the password should be updated in place")
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				// The resource pool changed outside of Terraform is set again.
+				PreConfig: func() {
+					w.alterOutsideTerraform("app@10.0.%", "default_pool")
+				},
+				Config: userConfig("second", `
+  user_password_version = 2
+  resource_pool         = "etl"
+  failed_login_attempts = 5
+  password_lock_time    = 600
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.this", "resource_pool", "etl"),
+				),
+			},
+		},
+	})
+
+	require.Nil(t, w.user("app@10.0.%"), "destroy should drop the user")
+}
+
+func TestSQLUserJWTConflictsWithPassword(t *testing.T) {
+	newFakeWorkspace(t)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIKey: testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config:      userConfig("first", "authentication_jwt = true"),
+				ExpectError: regexp.MustCompile("user_password cannot be set for users that authenticate with JWTs"),
+			},
+		},
+	})
+}
+
+func TestSQLUserRequiresPassword(t *testing.T) {
+	newFakeWorkspace(t)
+
+	testutil.UnitTest(t, testutil.UnitTestConfig{
+		APIKey: testutil.UnusedAPIKey,
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_sql_user" "this" {
+  endpoint = %q
+  password = "secret"
+  name     = "app"
+}
+`, testutil.TestWorkspaceEndpoint),
+				ExpectError: regexp.MustCompile("user_password is required for users that authenticate with a password"),
+			},
+		},
+	})
+}
+
+func TestCRUDSQLUserIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.SQLUserResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.app", "name", "app"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.app", "failed_login_attempts", "5"),
+					resource.TestCheckResourceAttr("singlestoredb_sql_user.sso", "authentication_jwt", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,330 @@
+package tables
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	storageColumnstore = "COLUMNSTORE"
+	storageRowstore    = "ROWSTORE"
+
+	indexKey      = "KEY"
+	indexUnique   = "UNIQUE"
+	indexFulltext = "FULLTEXT"
+	indexVector   = "VECTOR"
+)
+
+var (
+	storageTypes = []string{storageColumnstore, storageRowstore}
+	indexTypes   = []string{indexKey, indexUnique, indexFulltext, indexVector}
+)
+
+// tableDefinition is the schema of a table, as configured or as introspected.
+type tableDefinition struct {
+	Storage string
+	Columns []column
+	// The keys are nil if they are not configured, which lets the workspace choose them,
+	// e.g., the shard key defaults to the primary key.
+	PrimaryKey []string
+	ShardKey   []string
+	SortKey    []string
+	Indexes    []index
+}
+
+type column struct {
+	Name     string
+	Type     string
+	Nullable bool
+	// Default is a SQL expression, e.g., `0`, `'none'`, or `CURRENT_TIMESTAMP`, or nil for no default.
+	Default *string
+}
+
+type index struct {
+	Name    string
+	Type    string
+	Columns []string
+	// Options is appended to the index definition, e.g., `USING HASH` or `INDEX_OPTIONS '{"index_type": "AUTO"}'`.
+	Options string
+}
+
+// tableChanges is the difference between two definitions of a table.
+type tableChanges struct {
+	// Statements alter the table in place, in order.
+	Statements []string
+	// Replace lists the attributes whose changes cannot be made in place, which replace the table.
+	Replace []string
+}
+
+func createTableStatement(name string, def tableDefinition) string {
+	var parts []string
+	for _, c := range def.Columns {
+		parts = append(parts, columnDefinition(c))
+	}
+
+	if def.PrimaryKey != nil {
+		parts = append(parts, "PRIMARY KEY "+columnList(def.PrimaryKey))
+	}
+
+	if def.ShardKey != nil {
+		parts = append(parts, "SHARD KEY "+columnList(def.ShardKey))
+	}
+
+	// An empty sort key makes the table a columnstore table regardless of default_table_type.
+	if def.Storage == storageColumnstore {
+		parts = append(parts, "SORT KEY "+columnList(def.SortKey))
+	}
+
+	for _, i := range def.Indexes {
+		parts = append(parts, indexDefinition(i))
+	}
+
+	keyword := "TABLE"
+	if def.Storage == storageRowstore {
+		keyword = "ROWSTORE TABLE"
+	}
+
+	return fmt.Sprintf("CREATE %s %s (%s)", keyword, sql.QuoteIdentifier(name), strings.Join(parts, ", "))
+}
+
+// planTableChanges returns the statements that alter the table from the current to the desired definition,
+// or the attributes that require the replacement of the table instead.
+// The storage and the keys are compared by the schema, which requires replacement when they change.
+func planTableChanges(name string, current, desired tableDefinition) tableChanges {
+	var changes tableChanges
+
+	table := sql.QuoteIdentifier(name)
+	alter := func(format string, args ...any) {
+		changes.Statements = append(changes.Statements, fmt.Sprintf("ALTER TABLE %s %s", table, fmt.Sprintf(format, args...)))
+	}
+
+	currentColumns := columnsByName(current.Columns)
+	desiredColumns := columnsByName(desired.Columns)
+
+	// SingleStore cannot move existing columns.
+	kept := util.Filter(current.Columns, func(c column) bool { return desiredColumns[c.Name] != nil })
+	keptDesired := util.Filter(desired.Columns, func(c column) bool { return currentColumns[c.Name] != nil })
+	if !slices.EqualFunc(kept, keptDesired, func(a, b column) bool { return a.Name == b.Name }) {
+		changes.Replace = append(changes.Replace, "columns")
+	}
+
+	currentIndexes := indexesByName(current.Indexes)
+	desiredIndexes := indexesByName(desired.Indexes)
+
+	var dropIndexes, addIndexes []index
+	for _, i := range current.Indexes {
+		if d := desiredIndexes[i.Name]; d == nil || !equivalentIndexes(i, *d) {
+			dropIndexes = append(dropIndexes, i)
+		}
+	}
+
+	for _, i := range desired.Indexes {
+		if c := currentIndexes[i.Name]; c == nil || !equivalentIndexes(*c, i) {
+			addIndexes = append(addIndexes, i)
+		}
+	}
+
+	// Unique keys cannot be added to or dropped from an existing table.
+	if slices.ContainsFunc(slices.Concat(dropIndexes, addIndexes), func(i index) bool { return i.Type == indexUnique }) {
+		changes.Replace = append(changes.Replace, "indexes")
+	}
+
+	if len(changes.Replace) > 0 {
+		return tableChanges{Replace: changes.Replace}
+	}
+
+	for _, i := range dropIndexes {
+		alter("DROP INDEX %s", sql.QuoteIdentifier(i.Name))
+	}
+
+	for _, c := range current.Columns {
+		if desiredColumns[c.Name] == nil {
+			alter("DROP COLUMN %s", sql.QuoteIdentifier(c.Name))
+		}
+	}
+
+	for i, c := range desired.Columns {
+		cur := currentColumns[c.Name]
+		switch {
+		case cur == nil && i == 0:
+			alter("ADD COLUMN %s FIRST", columnDefinition(c))
+		case cur == nil:
+			alter("ADD COLUMN %s AFTER %s", columnDefinition(c), sql.QuoteIdentifier(desired.Columns[i-1].Name))
+		case !equivalentColumns(*cur, c):
+			alter("MODIFY COLUMN %s", columnDefinition(c))
+		}
+	}
+
+	for _, i := range addIndexes {
+		alter("ADD %s", indexDefinition(i))
+	}
+
+	return changes
+}
+
+func columnDefinition(c column) string {
+	definition := sql.QuoteIdentifier(c.Name) + " " + c.Type
+	if !c.Nullable {
+		definition += " NOT NULL"
+	}
+
+	if c.Default != nil {
+		definition += " DEFAULT " + *c.Default
+	}
+
+	return definition
+}
+
+func indexDefinition(i index) string {
+	var definition string
+
+	switch i.Type {
+	case indexUnique:
+		definition = "UNIQUE KEY " + sql.QuoteIdentifier(i.Name)
+	case indexFulltext:
+		definition = "FULLTEXT USING VERSION 2 " + sql.QuoteIdentifier(i.Name)
+	case indexVector:
+		definition = "VECTOR INDEX " + sql.QuoteIdentifier(i.Name)
+	default:
+		definition = "KEY " + sql.QuoteIdentifier(i.Name)
+	}
+
+	definition += " " + columnList(i.Columns)
+	if i.Options != "" {
+		definition += " " + i.Options
+	}
+
+	return definition
+}
+
+func columnList(columns []string) string {
+	return "(" + strings.Join(util.Map(columns, sql.QuoteIdentifier), ", ") + ")"
+}
+
+func columnsByName(columns []column) map[string]*column {
+	result := make(map[string]*column, len(columns))
+	for i := range columns {
+		result[columns[i].Name] = &columns[i]
+	}
+
+	return result
+}
+
+func indexesByName(indexes []index) map[string]*index {
+	result := make(map[string]*index, len(indexes))
+	for i := range indexes {
+		result[indexes[i].Name] = &indexes[i]
+	}
+
+	return result
+}
+
+func equivalentColumns(a, b column) bool {
+	return a.Name == b.Name &&
+		normalizeType(a.Type) == normalizeType(b.Type) &&
+		a.Nullable == b.Nullable &&
+		equivalentDefaults(a.Default, b.Default)
+}
+
+// equivalentIndexes compares the options as well, which are not introspected;
+// introspected indexes take the options of the configuration when the rest matches.
+func equivalentIndexes(a, b index) bool {
+	return a.Name == b.Name &&
+		a.Type == b.Type &&
+		slices.Equal(a.Columns, b.Columns) &&
+		strings.EqualFold(strings.Join(strings.Fields(a.Options), " "), strings.Join(strings.Fields(b.Options), " "))
+}
+
+var (
+	typeSpaceRegexp    = regexp.MustCompile(`\s*([(,])\s*|\s*(\))`)
+	integerWidthRegexp = regexp.MustCompile(`^(tinyint|smallint|mediumint|int|bigint)\(\d+\)`)
+	decimalRegexp      = regexp.MustCompile(`^decimal(\((\d+)\))?($|\s)`)
+	vectorRegexp       = regexp.MustCompile(`^vector\((\d+)\)`)
+
+	typeAliases = map[string]string{
+		"integer":          "int",
+		"bool":             "tinyint(1)",
+		"boolean":          "tinyint(1)",
+		"double precision": "double",
+		"real":             "double",
+		"dec":              "decimal",
+		"numeric":          "decimal",
+		"fixed":            "decimal",
+	}
+)
+
+// normalizeType returns a canonical form of a column type, so that, e.g., `INT` and `int(11)`,
+// or `DECIMAL(10, 2)` and `decimal(10,2)`, compare equal.
+func normalizeType(t string) string {
+	t = strings.ToLower(strings.Join(strings.Fields(t), " "))
+	t = typeSpaceRegexp.ReplaceAllString(t, "$1$2")
+
+	for alias, name := range typeAliases {
+		if t == alias || strings.HasPrefix(t, alias+"(") || strings.HasPrefix(t, alias+" ") {
+			t = name + t[len(alias):]
+
+			break
+		}
+	}
+
+	if !strings.HasPrefix(t, "tinyint(1)") {
+		t = integerWidthRegexp.ReplaceAllString(t, "$1")
+	}
+
+	if m := decimalRegexp.FindStringSubmatch(t); m != nil {
+		precision := m[2]
+		if precision == "" {
+			precision = "10"
+		}
+
+		t = "decimal(" + precision + ",0)" + t[len(m[0])-len(m[3]):]
+	}
+
+	t = vectorRegexp.ReplaceAllString(t, "vector($1,f32)")
+
+	return t
+}
+
+// equivalentDefaults compares two default expressions, ignoring the quotes of literals,
+// the case of keywords, and the formatting of numbers.
+func equivalentDefaults(a, b *string) bool {
+	x, y := normalizeDefault(a), normalizeDefault(b)
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	if *x == *y || strings.EqualFold(*x, *y) {
+		return true
+	}
+
+	if r, ok := new(big.Rat).SetString(*x); ok {
+		if s, ok := new(big.Rat).SetString(*y); ok {
+			return r.Cmp(s) == 0
+		}
+	}
+
+	return false
+}
+
+func normalizeDefault(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	v := strings.TrimSpace(*value)
+	if strings.EqualFold(v, "NULL") {
+		return nil
+	}
+
+	if len(v) >= 2 && v[0] == '\'' && v[len(v)-1] == '\'' {
+		v = strings.NewReplacer(`\'`, `'`, `''`, `'`, `\\`, `\`).Replace(v[1 : len(v)-1])
+	}
+
+	return &v
+}
@@ -0,0 +1,207 @@
+package tables
+
+import (
+	"context"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+)
+
+const (
+	// primaryIndexName and shardIndexName are the names that information_schema.STATISTICS reports
+	// for the primary key and the shard key.
+	primaryIndexName = "PRIMARY"
+	shardIndexName   = "__SHARDKEY"
+)
+
+var (
+	vectorIndexTypeRegexp = regexp.MustCompile(`^(VECTOR|AUTO|IVF_|HNSW_)`)
+	defaultKeywordRegexp  = regexp.MustCompile(`(?i)^(CURRENT_TIMESTAMP|CURRENT_DATE|CURRENT_TIME|NOW|LOCALTIME|LOCALTIMESTAMP)(\(\d*\))?$`)
+)
+
+// readTable returns the definition of the table from information_schema, or nil if the table does not exist.
+func readTable(ctx context.Context, client *sql.Client, database, name string) (*tableDefinition, error) {
+	args := []any{database, name}
+
+	rows, err := sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL:  "SELECT STORAGE_TYPE FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?",
+		Args: args,
+	})
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+
+	// The rowstore tables are reported as INMEMORY_ROWSTORE.
+	def := tableDefinition{Storage: storageRowstore}
+	if strings.Contains(strings.ToUpper(rows[0]["STORAGE_TYPE"]), storageColumnstore) {
+		def.Storage = storageColumnstore
+	}
+
+	rows, err = sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL: "SELECT COLUMN_NAME, COLUMN_TYPE, IS_NULLABLE, COLUMN_DEFAULT, COLUMN_DEFAULT IS NULL AS NO_DEFAULT " +
+			"FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION",
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		c := column{
+			Name:     row["COLUMN_NAME"],
+			Type:     row["COLUMN_TYPE"],
+			Nullable: strings.EqualFold(row["IS_NULLABLE"], "YES"),
+		}
+
+		if row["NO_DEFAULT"] != "1" && row["NO_DEFAULT"] != "true" {
+			value := defaultExpression(row["COLUMN_DEFAULT"])
+			c.Default = &value
+		}
+
+		def.Columns = append(def.Columns, c)
+	}
+
+	rows, err = sql.QueryStringRows(ctx, client, sql.ExecRequest{
+		SQL: "SELECT INDEX_NAME, NON_UNIQUE, COLUMN_NAME, INDEX_TYPE " +
+			"FROM information_schema.STATISTICS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY INDEX_NAME, SEQ_IN_INDEX",
+		Args: args,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	applyIndexRows(&def, rows)
+
+	return &def, nil
+}
+
+// applyIndexRows sorts the rows of information_schema.STATISTICS into the keys and the indexes of the table.
+func applyIndexRows(def *tableDefinition, rows []map[string]string) {
+	positions := map[string]int{}
+
+	for _, row := range rows {
+		name, columnName := row["INDEX_NAME"], row["COLUMN_NAME"]
+		indexType := strings.ToUpper(row["INDEX_TYPE"])
+
+		var key *[]string
+		switch {
+		case name == primaryIndexName:
+			key = &def.PrimaryKey
+		case name == shardIndexName:
+			key = &def.ShardKey
+		case strings.Contains(indexType, storageColumnstore):
+			// The sort key is the clustered columnstore index; an empty sort key has no columns.
+			key = &def.SortKey
+		}
+
+		if key != nil {
+			if columnName != "" {
+				*key = append(*key, columnName)
+			}
+
+			continue
+		}
+
+		i, ok := positions[name]
+		if !ok {
+			i = len(def.Indexes)
+			positions[name] = i
+			def.Indexes = append(def.Indexes, index{Name: name, Type: liveIndexType(indexType, row["NON_UNIQUE"])})
+		}
+
+		def.Indexes[i].Columns = append(def.Indexes[i].Columns, columnName)
+	}
+}
+
+func liveIndexType(indexType, nonUnique string) string {
+	switch {
+	case indexType == indexFulltext:
+		return indexFulltext
+	case vectorIndexTypeRegexp.MatchString(indexType):
+		return indexVector
+	case nonUnique == "0" || nonUnique == "false":
+		return indexUnique
+	default:
+		return indexKey
+	}
+}
+
+// defaultExpression turns the value of information_schema.COLUMNS.COLUMN_DEFAULT into a SQL expression.
+func defaultExpression(value string) string {
+	if _, ok := new(big.Rat).SetString(value); ok || defaultKeywordRegexp.MatchString(value) {
+		return value
+	}
+
+	return sql.QuoteString(value)
+}
+
+// mergeLive returns the live definition of the table in the terms of the known definition:
+// equivalent columns and indexes keep their known spelling and options, the indexes keep their known order,
+// and the keys that are not known stay unset while the workspace reports their defaults.
+func mergeLive(known, live tableDefinition) tableDefinition {
+	result := tableDefinition{Storage: live.Storage}
+
+	knownColumns := columnsByName(known.Columns)
+	for _, c := range live.Columns {
+		if k := knownColumns[c.Name]; k != nil && equivalentColumns(*k, c) {
+			c = *k
+		}
+
+		result.Columns = append(result.Columns, c)
+	}
+
+	knownIndexes := indexesByName(known.Indexes)
+	for _, i := range live.Indexes {
+		if k := knownIndexes[i.Name]; k != nil && k.Type == i.Type && slices.Equal(k.Columns, i.Columns) {
+			i = *k
+		}
+
+		result.Indexes = append(result.Indexes, i)
+	}
+
+	order := make(map[string]int, len(known.Indexes))
+	for n, i := range known.Indexes {
+		order[i.Name] = n
+	}
+
+	slices.SortStableFunc(result.Indexes, func(a, b index) int {
+		x, xok := order[a.Name]
+		y, yok := order[b.Name]
+
+		switch {
+		case xok && yok:
+			return x - y
+		case xok:
+			return -1
+		case yok:
+			return 1
+		default:
+			return strings.Compare(a.Name, b.Name)
+		}
+	})
+
+	if known.Indexes != nil && result.Indexes == nil {
+		result.Indexes = []index{}
+	}
+
+	result.PrimaryKey = mergeKey(known.PrimaryKey, live.PrimaryKey, len(live.PrimaryKey) == 0)
+	// The shard key defaults to the primary key.
+	result.ShardKey = mergeKey(known.ShardKey, live.ShardKey, len(live.ShardKey) == 0 || slices.Equal(live.ShardKey, live.PrimaryKey))
+	result.SortKey = mergeKey(known.SortKey, live.SortKey, len(live.SortKey) == 0)
+
+	return result
+}
+
+func mergeKey(known, live []string, isDefault bool) []string {
+	switch {
+	case known == nil && isDefault:
+		return nil
+	case live == nil:
+		return []string{}
+	default:
+		return live
+	}
+}
@@ -0,0 +1,589 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+const (
+	ResourceName = "table"
+)
+
+var (
+	_ resource.Resource                   = &tableResource{}
+	_ resource.ResourceWithConfigure      = &tableResource{}
+	_ resource.ResourceWithValidateConfig = &tableResource{}
+	_ resource.ResourceWithModifyPlan     = &tableResource{}
+	_ resource.ResourceWithImportState    = &tableResource{}
+
+	columnAttrTypes = map[string]attr.Type{
+		"name":     types.StringType,
+		"type":     types.StringType,
+		"nullable": types.BoolType,
+		"default":  types.StringType,
+	}
+
+	indexAttrTypes = map[string]attr.Type{
+		"name":    types.StringType,
+		"type":    types.StringType,
+		"columns": types.ListType{ElemType: types.StringType},
+		"options": types.StringType,
+	}
+)
+
+type tableResourceModel struct {
+	sql.ConnectionModel
+	ID         types.String `tfsdk:"id"`
+	Database   types.String `tfsdk:"database"`
+	Name       types.String `tfsdk:"name"`
+	Storage    types.String `tfsdk:"storage"`
+	Columns    types.List   `tfsdk:"columns"`
+	PrimaryKey types.List   `tfsdk:"primary_key"`
+	ShardKey   types.List   `tfsdk:"shard_key"`
+	SortKey    types.List   `tfsdk:"sort_key"`
+	Indexes    types.List   `tfsdk:"indexes"`
+}
+
+type columnModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Nullable types.Bool   `tfsdk:"nullable"`
+	Default  types.String `tfsdk:"default"`
+}
+
+type indexModel struct {
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Columns types.List   `tfsdk:"columns"`
+	Options types.String `tfsdk:"options"`
+}
+
+type tableResource struct {
+	sql.Connector
+}
+
+func NewResource() resource.Resource {
+	return &tableResource{}
+}
+
+func (r *tableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = util.ResourceTypeName(req, ResourceName)
+}
+
+func (r *tableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	keyAttribute := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: description + " SingleStore cannot alter the key of an existing table, so changing this value forces replacement.",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a table of a SingleStore Helios workspace via the Data API. " +
+			"The table is described by its columns, keys, and indexes rather than by a `CREATE TABLE` statement. " +
+			"Changes are planned as `ALTER TABLE` statements where SingleStore supports them: adding, dropping, and modifying columns, " +
+			"and adding and dropping non-unique, full-text, and vector indexes. " +
+			"Changes to the storage, the keys, the unique keys, or the order of the existing columns replace the table, which drops its data. " +
+			"A renamed column is planned as dropping the column and adding a new one. " +
+			"Changes made outside of Terraform are detected through `information_schema.COLUMNS` and `information_schema.STATISTICS`.",
+		Attributes: sql.WithConnectionAttributes(map[string]schema.Attribute{
+			config.IDAttribute: schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the table in the form `<endpoint>/<database>/<name>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The database of the table. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the table. Changing this value forces replacement.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"storage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(storageColumnstore),
+				MarkdownDescription: fmt.Sprintf("The storage of the table. Valid values are %s. Defaults to `%s`. Changing this value forces replacement.",
+					util.Join(storageTypes, ", "), storageColumnstore,
+				),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(storageTypes...),
+				},
+			},
+			"columns": schema.ListNestedAttribute{
+				Required: true,
+				MarkdownDescription: "The columns of the table in order. New columns are added at their position. " +
+					"Reordering the existing columns forces replacement.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the column.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 64),
+							},
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							MarkdownDescription: "The data type of the column, e.g., `BIGINT`, `VARCHAR(255)`, `DECIMAL(10, 2)`, or `VECTOR(1536)`. " +
+								"Spellings that the workspace reports differently, e.g., `INT` and `int(11)`, are treated as the same type.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"nullable": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Whether the column accepts NULL. Defaults to `true`.",
+						},
+						"default": schema.StringAttribute{
+							Optional: true,
+							MarkdownDescription: "The default value of the column as a SQL expression, e.g., `0`, `'pending'` with the quotes, or `CURRENT_TIMESTAMP`. " +
+								"Omit for no default.",
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"primary_key": keyAttribute("The columns of the primary key. The shard key defaults to the primary key."),
+			"shard_key": keyAttribute("The columns of the shard key, which distributes the rows among the partitions. " +
+				"Omit to default to the primary key, or set to an empty list for keyless sharding."),
+			"sort_key": keyAttribute(fmt.Sprintf("The columns of the sort key of a `%s` table.", storageColumnstore)),
+			"indexes": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The secondary indexes of the table. " +
+					"Indexes are added and dropped in place, except for unique keys, whose changes force replacement.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the index.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 64),
+							},
+						},
+						"type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(indexKey),
+							MarkdownDescription: fmt.Sprintf("The type of the index. Valid values are %s. Defaults to `%s`. "+
+								"`%s` creates a version 2 full-text index, and `%s` an index on a single `VECTOR` column.",
+								util.Join(indexTypes, ", "), indexKey, indexFulltext, indexVector,
+							),
+							Validators: []validator.String{
+								stringvalidator.OneOf(indexTypes...),
+							},
+						},
+						"columns": schema.ListAttribute{
+							Required:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The columns of the index in order.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"options": schema.StringAttribute{
+							Optional: true,
+							MarkdownDescription: "The SQL appended to the index definition, e.g., `USING HASH` or `INDEX_OPTIONS '{\"index_type\": \"AUTO\"}'`. " +
+								"The workspace does not report the options, so changes made outside of Terraform are not detected.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func (r *tableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var conf tableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if resp.Diagnostics.HasError() || !isFullyKnown(ctx, conf.Storage, conf.Columns, conf.SortKey, conf.Indexes) {
+		return
+	}
+
+	def, diags := toDefinition(ctx, conf)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, c := range def.Columns {
+		if seen[c.Name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("columns").AtListIndex(i).AtName("name"),
+				"Invalid configuration",
+				fmt.Sprintf("The column %q is listed more than once.", c.Name),
+			)
+		}
+
+		seen[c.Name] = true
+	}
+
+	seen = map[string]bool{}
+	for i, index := range def.Indexes {
+		if seen[index.Name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("indexes").AtListIndex(i).AtName("name"),
+				"Invalid configuration",
+				fmt.Sprintf("The index %q is listed more than once.", index.Name),
+			)
+		}
+
+		seen[index.Name] = true
+
+		if def.Storage == storageRowstore && (index.Type == indexFulltext || index.Type == indexVector) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("indexes").AtListIndex(i).AtName("type"),
+				"Invalid configuration",
+				fmt.Sprintf("%s indexes require %s storage.", index.Type, storageColumnstore),
+			)
+		}
+	}
+
+	if def.Storage == storageRowstore && def.SortKey != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("sort_key"),
+			"Invalid configuration",
+			fmt.Sprintf("A sort key requires %s storage.", storageColumnstore),
+		)
+	}
+}
+
+func (r *tableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state tableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !isFullyKnown(ctx, plan.Columns, plan.Indexes) {
+		return
+	}
+
+	current, diags := toDefinition(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := toDefinition(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attribute := range planTableChanges(plan.Name.ValueString(), current, desired).Replace {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attribute))
+	}
+}
+
+func (r *tableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan tableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	def, diags := toDefinition(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statement := createTableStatement(plan.Name.ValueString(), def)
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement, Database: plan.Database.ValueString()}); err != nil {
+		serr := sql.DiagnosticFromError(err)
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	plan.ConnectionModel = plan.ForState()
+	plan.ID = types.StringValue(sql.ImportID(plan.Endpoint.ValueString(), plan.Database.ValueString(), plan.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *tableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state tableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	live, err := readTable(ctx, client, state.Database.ValueString(), state.Name.ValueString())
+	if err != nil {
+		sql.WarnUnreachableOnRead(&resp.Diagnostics, err)
+
+		return
+	}
+
+	if live == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	known, diags := toDefinition(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The differences from the configuration plan the ALTER TABLE statements, or the replacement, that restore it.
+	state.ConnectionModel = state.ForState()
+	resp.Diagnostics.Append(fromDefinition(ctx, &state, mergeLive(known, *live))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *tableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state tableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := plan.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	current, diags := toDefinition(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := toDefinition(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statements := planTableChanges(plan.Name.ValueString(), current, desired).Statements
+	for i, statement := range statements {
+		if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement, Database: plan.Database.ValueString()}); err != nil {
+			// The statements before the failure stay applied; the next refresh reads them from the workspace.
+			serr := sql.DiagnosticFromError(err)
+			resp.Diagnostics.AddError(serr.Summary, fmt.Sprintf("Statement %d of %d, %s, failed. %s", i+1, len(statements), statement, serr.Detail))
+
+			return
+		}
+	}
+
+	plan.ConnectionModel = plan.ForState()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *tableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state tableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, serr := state.Client(r.Connector)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	statement := "DROP TABLE IF EXISTS " + sql.QuoteIdentifier(state.Name.ValueString())
+	if _, err := client.Exec(ctx, sql.ExecRequest{SQL: statement, Database: state.Database.ValueString()}); err != nil {
+		sql.WarnUnreachableOnDelete(&resp.Diagnostics, err)
+
+		return
+	}
+}
+
+// Configure adds the provider configured Data API HTTP client to the resource.
+func (r *tableResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.Connector = sql.NewConnector(req.ProviderData)
+}
+
+func (r *tableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := sql.ImportConnection(ctx, req, resp, "`<endpoint>/<database>/<name>`", 2)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), names[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), names[1])...)
+}
+
+// toDefinition converts the model. Null lists become nil, and unknown values are treated as null.
+func toDefinition(ctx context.Context, model tableResourceModel) (tableDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	def := tableDefinition{Storage: model.Storage.ValueString()}
+
+	var columns []columnModel
+	if !model.Columns.IsNull() && !model.Columns.IsUnknown() {
+		diags.Append(model.Columns.ElementsAs(ctx, &columns, false)...)
+	}
+
+	for _, c := range columns {
+		def.Columns = append(def.Columns, column{
+			Name:     c.Name.ValueString(),
+			Type:     c.Type.ValueString(),
+			Nullable: c.Nullable.IsNull() || c.Nullable.ValueBool(),
+			Default:  util.MaybeString(c.Default),
+		})
+	}
+
+	def.PrimaryKey = stringsOf(ctx, model.PrimaryKey, &diags)
+	def.ShardKey = stringsOf(ctx, model.ShardKey, &diags)
+	def.SortKey = stringsOf(ctx, model.SortKey, &diags)
+
+	var indexes []indexModel
+	if !model.Indexes.IsNull() && !model.Indexes.IsUnknown() {
+		diags.Append(model.Indexes.ElementsAs(ctx, &indexes, false)...)
+		def.Indexes = []index{}
+	}
+
+	for _, i := range indexes {
+		def.Indexes = append(def.Indexes, index{
+			Name:    i.Name.ValueString(),
+			Type:    util.FirstNotEmpty(i.Type.ValueString(), indexKey),
+			Columns: stringsOf(ctx, i.Columns, &diags),
+			Options: i.Options.ValueString(),
+		})
+	}
+
+	return def, diags
+}
+
+// fromDefinition sets the storage, the columns, the keys, and the indexes of the model.
+func fromDefinition(ctx context.Context, model *tableResourceModel, def tableDefinition) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	model.Storage = types.StringValue(def.Storage)
+
+	model.Columns, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: columnAttrTypes}, util.Map(def.Columns, func(c column) columnModel {
+		return columnModel{
+			Name:     types.StringValue(c.Name),
+			Type:     types.StringValue(c.Type),
+			Nullable: types.BoolValue(c.Nullable),
+			Default:  types.StringPointerValue(c.Default),
+		}
+	}))
+	diags.Append(d...)
+
+	model.PrimaryKey = listOf(def.PrimaryKey)
+	model.ShardKey = listOf(def.ShardKey)
+	model.SortKey = listOf(def.SortKey)
+
+	model.Indexes = types.ListNull(types.ObjectType{AttrTypes: indexAttrTypes})
+	if def.Indexes != nil {
+		model.Indexes, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: indexAttrTypes}, util.Map(def.Indexes, func(i index) indexModel {
+			options := types.StringNull()
+			if i.Options != "" {
+				options = types.StringValue(i.Options)
+			}
+
+			return indexModel{
+				Name:    types.StringValue(i.Name),
+				Type:    types.StringValue(i.Type),
+				Columns: listOf(i.Columns),
+				Options: options,
+			}
+		}))
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// stringsOf returns nil for a null or unknown list, and a non-nil slice otherwise.
+func stringsOf(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	result := []string{}
+	diags.Append(list.ElementsAs(ctx, &result, false)...)
+
+	return result
+}
+
+func listOf(values []string) types.List {
+	if values == nil {
+		return types.ListNull(types.StringType)
+	}
+
+	return types.ListValueMust(types.StringType, util.Map(values, func(v string) attr.Value { return types.StringValue(v) }))
+}
+
+func isFullyKnown(ctx context.Context, values ...attr.Value) bool {
+	for _, value := range values {
+		v, err := value.ToTerraformValue(ctx)
+		if err != nil || !v.IsFullyKnown() {
+			return false
+		}
+	}
+
+	return true
+}
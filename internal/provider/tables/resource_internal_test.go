@@ -0,0 +1,209 @@
+package tables
+
+import (
+	"testing"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func ordersDefinition() tableDefinition {
+	return tableDefinition{
+		Storage: storageColumnstore,
+		Columns: []column{
+			{Name: "order_id", Type: "BIGINT"},
+			{Name: "status", Type: "VARCHAR(32)", Nullable: true, Default: util.Ptr("'pending'")},
+			{Name: "notes", Type: "TEXT", Nullable: true},
+		},
+		ShardKey: []string{"order_id"},
+		SortKey:  []string{"order_id"},
+		Indexes: []index{
+			{Name: "status_idx", Type: indexKey, Columns: []string{"status"}, Options: "USING HASH"},
+		},
+	}
+}
+
+func TestCreateTableStatement(t *testing.T) {
+	require.Equal(t,
+		"CREATE TABLE `orders` (`order_id` BIGINT NOT NULL, `status` VARCHAR(32) DEFAULT 'pending', `notes` TEXT, "+
+			"SHARD KEY (`order_id`), SORT KEY (`order_id`), KEY `status_idx` (`status`) USING HASH)",
+		createTableStatement("orders", ordersDefinition()),
+	)
+
+	require.Equal(t,
+		"CREATE TABLE `docs` (`id` BIGINT NOT NULL, `body` TEXT, `embedding` VECTOR(4), PRIMARY KEY (`id`), SORT KEY (), "+
+			"FULLTEXT USING VERSION 2 `body_ft` (`body`), VECTOR INDEX `embedding_idx` (`embedding`) INDEX_OPTIONS '{\"index_type\": \"AUTO\"}')",
+		createTableStatement("docs", tableDefinition{
+			Storage: storageColumnstore,
+			Columns: []column{
+				{Name: "id", Type: "BIGINT"},
+				{Name: "body", Type: "TEXT", Nullable: true},
+				{Name: "embedding", Type: "VECTOR(4)", Nullable: true},
+			},
+			PrimaryKey: []string{"id"},
+			Indexes: []index{
+				{Name: "body_ft", Type: indexFulltext, Columns: []string{"body"}},
+				{Name: "embedding_idx", Type: indexVector, Columns: []string{"embedding"}, Options: `INDEX_OPTIONS '{"index_type": "AUTO"}'`},
+			},
+		}),
+		"an empty sort key keeps the table in columnstore storage",
+	)
+
+	require.Equal(t,
+		"CREATE ROWSTORE TABLE `settings` (`name` VARCHAR(64) NOT NULL, `value` JSON, PRIMARY KEY (`name`), UNIQUE KEY `value_idx` (`value`))",
+		createTableStatement("settings", tableDefinition{
+			Storage:    storageRowstore,
+			Columns:    []column{{Name: "name", Type: "VARCHAR(64)"}, {Name: "value", Type: "JSON", Nullable: true}},
+			PrimaryKey: []string{"name"},
+			Indexes:    []index{{Name: "value_idx", Type: indexUnique, Columns: []string{"value"}}},
+		}),
+	)
+}
+
+func TestPlanTableChanges(t *testing.T) {
+	current := ordersDefinition()
+
+	require.Empty(t, planTableChanges("orders", current, current))
+
+	desired := ordersDefinition()
+	desired.Columns = []column{
+		{Name: "order_id", Type: "bigint(20)"},
+		{Name: "customer_id", Type: "BIGINT", Default: util.Ptr("0")},
+		{Name: "status", Type: "VARCHAR(64)", Nullable: true, Default: util.Ptr("'pending'")},
+	}
+	desired.Indexes = []index{
+		{Name: "status_idx", Type: indexKey, Columns: []string{"status"}, Options: "using  hash"},
+		{Name: "customer_idx", Type: indexKey, Columns: []string{"customer_id"}},
+	}
+
+	require.Equal(t, tableChanges{
+		Statements: []string{
+			"ALTER TABLE `orders` DROP COLUMN `notes`",
+			"ALTER TABLE `orders` ADD COLUMN `customer_id` BIGINT NOT NULL DEFAULT 0 AFTER `order_id`",
+			"ALTER TABLE `orders` MODIFY COLUMN `status` VARCHAR(64) DEFAULT 'pending'",
+			"ALTER TABLE `orders` ADD KEY `customer_idx` (`customer_id`)",
+		},
+	}, planTableChanges("orders", current, desired))
+
+	desired = ordersDefinition()
+	desired.Columns = append([]column{{Name: "id", Type: "BIGINT"}}, desired.Columns...)
+	desired.Indexes = []index{{Name: "status_idx", Type: indexKey, Columns: []string{"status", "notes"}}}
+
+	require.Equal(t, tableChanges{
+		Statements: []string{
+			"ALTER TABLE `orders` DROP INDEX `status_idx`",
+			"ALTER TABLE `orders` ADD COLUMN `id` BIGINT NOT NULL FIRST",
+			"ALTER TABLE `orders` ADD KEY `status_idx` (`status`, `notes`)",
+		},
+	}, planTableChanges("orders", current, desired), "a changed index is dropped and added again")
+
+	desired = ordersDefinition()
+	desired.Columns[1], desired.Columns[2] = desired.Columns[2], desired.Columns[1]
+	desired.Indexes = append(desired.Indexes, index{Name: "notes_idx", Type: indexUnique, Columns: []string{"notes"}})
+
+	require.Equal(t, tableChanges{Replace: []string{"columns", "indexes"}}, planTableChanges("orders", current, desired))
+}
+
+func TestNormalizeType(t *testing.T) {
+	for _, types := range [][]string{
+		{"INT", "int(11)", "Integer", "INT(10)"},
+		{"BIGINT UNSIGNED", "bigint(20) unsigned"},
+		{"BOOL", "tinyint(1)", "boolean"},
+		{"DECIMAL", "decimal(10,0)", "NUMERIC(10)"},
+		{"DECIMAL(10, 2)", "decimal(10,2)"},
+		{"VARCHAR(255)", "varchar( 255 )"},
+		{"VECTOR(4)", "vector(4, F32)"},
+		{"DOUBLE PRECISION", "double"},
+	} {
+		for _, other := range types[1:] {
+			require.Equal(t, normalizeType(types[0]), normalizeType(other), "%s and %s", types[0], other)
+		}
+	}
+
+	require.NotEqual(t, normalizeType("TINYINT"), normalizeType("BOOL"))
+	require.NotEqual(t, normalizeType("VARCHAR(255)"), normalizeType("VARCHAR(256)"))
+	require.NotEqual(t, normalizeType("VECTOR(4, I8)"), normalizeType("VECTOR(4)"))
+}
+
+func TestEquivalentDefaults(t *testing.T) {
+	require.True(t, equivalentDefaults(nil, nil))
+	require.True(t, equivalentDefaults(nil, util.Ptr("NULL")))
+	require.True(t, equivalentDefaults(util.Ptr("'pending'"), util.Ptr("'pending'")))
+	require.True(t, equivalentDefaults(util.Ptr("'it''s'"), util.Ptr(`'it\'s'`)))
+	require.True(t, equivalentDefaults(util.Ptr("0"), util.Ptr("0.00")))
+	require.True(t, equivalentDefaults(util.Ptr("current_timestamp(6)"), util.Ptr("CURRENT_TIMESTAMP(6)")))
+	require.False(t, equivalentDefaults(util.Ptr("''"), nil))
+	require.False(t, equivalentDefaults(util.Ptr("1"), util.Ptr("0")))
+	require.False(t, equivalentDefaults(util.Ptr("'a'"), util.Ptr("'b'")))
+}
+
+func TestApplyIndexRows(t *testing.T) {
+	var def tableDefinition
+	applyIndexRows(&def, []map[string]string{
+		{"INDEX_NAME": "PRIMARY", "NON_UNIQUE": "0", "COLUMN_NAME": "id", "INDEX_TYPE": "HASH"},
+		{"INDEX_NAME": "__SHARDKEY", "NON_UNIQUE": "1", "COLUMN_NAME": "id", "INDEX_TYPE": "METADATA_ONLY"},
+		{"INDEX_NAME": "created_at", "NON_UNIQUE": "1", "COLUMN_NAME": "created_at", "INDEX_TYPE": "CLUSTERED COLUMNSTORE"},
+		{"INDEX_NAME": "body_ft", "NON_UNIQUE": "1", "COLUMN_NAME": "body", "INDEX_TYPE": "FULLTEXT"},
+		{"INDEX_NAME": "embedding_idx", "NON_UNIQUE": "1", "COLUMN_NAME": "embedding", "INDEX_TYPE": "VECTOR"},
+		{"INDEX_NAME": "name_idx", "NON_UNIQUE": "0", "COLUMN_NAME": "first_name", "INDEX_TYPE": "HASH"},
+		{"INDEX_NAME": "name_idx", "NON_UNIQUE": "0", "COLUMN_NAME": "last_name", "INDEX_TYPE": "HASH"},
+	})
+
+	require.Equal(t, tableDefinition{
+		PrimaryKey: []string{"id"},
+		ShardKey:   []string{"id"},
+		SortKey:    []string{"created_at"},
+		Indexes: []index{
+			{Name: "body_ft", Type: indexFulltext, Columns: []string{"body"}},
+			{Name: "embedding_idx", Type: indexVector, Columns: []string{"embedding"}},
+			{Name: "name_idx", Type: indexUnique, Columns: []string{"first_name", "last_name"}},
+		},
+	}, def)
+}
+
+func TestMergeLive(t *testing.T) {
+	known := ordersDefinition()
+	known.ShardKey = nil
+	known.PrimaryKey = []string{"order_id"}
+	known.Indexes = append([]index{{Name: "notes_ft", Type: indexFulltext, Columns: []string{"notes"}}}, known.Indexes...)
+
+	live := tableDefinition{
+		Storage: storageColumnstore,
+		Columns: []column{
+			{Name: "order_id", Type: "bigint(20)"},
+			{Name: "status", Type: "varchar(32)", Nullable: true, Default: util.Ptr("'pending'")},
+			{Name: "notes", Type: "longtext", Nullable: true},
+			{Name: "added", Type: "int(11)", Nullable: true},
+		},
+		PrimaryKey: []string{"order_id"},
+		ShardKey:   []string{"order_id"},
+		SortKey:    []string{"order_id"},
+		Indexes: []index{
+			{Name: "extra_idx", Type: indexKey, Columns: []string{"added"}},
+			{Name: "status_idx", Type: indexKey, Columns: []string{"status"}},
+		},
+	}
+
+	require.Equal(t, tableDefinition{
+		Storage: storageColumnstore,
+		Columns: []column{
+			known.Columns[0],
+			known.Columns[1],
+			{Name: "notes", Type: "longtext", Nullable: true},
+			{Name: "added", Type: "int(11)", Nullable: true},
+		},
+		PrimaryKey: []string{"order_id"},
+		SortKey:    []string{"order_id"},
+		Indexes: []index{
+			known.Indexes[1],
+			{Name: "extra_idx", Type: indexKey, Columns: []string{"added"}},
+		},
+	}, mergeLive(known, live), "the shard key stays unset while it defaults to the primary key")
+
+	live.ShardKey = nil
+	live.Indexes = nil
+	known.ShardKey = []string{}
+	merged := mergeLive(known, live)
+	require.Equal(t, []string{}, merged.ShardKey, "keyless sharding stays configured")
+	require.Equal(t, []index{}, merged.Indexes, "dropped indexes leave an empty list")
+}
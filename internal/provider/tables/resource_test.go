@@ -0,0 +1,269 @@
+package tables_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/examples"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	addColumnRegexp  = regexp.MustCompile("^ALTER TABLE `orders` ADD COLUMN `(\\w+)` (.+?)( NOT NULL)? AFTER `(\\w+)`$")
+	dropColumnRegexp = regexp.MustCompile("^ALTER TABLE `orders` DROP COLUMN `(\\w+)`$")
+
+	// engineTypes are the column types in the form information_schema reports them.
+	engineTypes = map[string]string{
+		"BIGINT":         "bigint(20)",
+		"DECIMAL(10, 2)": "decimal(10,2)",
+		"TEXT":           "text",
+	}
+)
+
+// fakeTables keeps the table `app`.`orders` of a mock workspace, which is created with
+// the columns order_id and amount and is sharded and sorted by order_id.
+type fakeTables struct {
+	*testutil.FakeWorkspace
+	columns []map[string]any
+}
+
+func newFakeTables(t *testing.T) *fakeTables {
+	t.Helper()
+
+	w := &fakeTables{}
+
+	// exec records the statement before it changes the table.
+	exec := func(f func(m []string) error) func(testutil.DataAPIRequest, []string) error {
+		return func(req testutil.DataAPIRequest, m []string) error {
+			require.Equal(t, "app", req.Database)
+			w.Record(req.SQL)
+
+			return f(m)
+		}
+	}
+
+	// query answers with the rows only while the table exists.
+	query := func(rows func() []map[string]any) func(testutil.DataAPIRequest, []string) ([]map[string]any, error) {
+		return func(req testutil.DataAPIRequest, _ []string) ([]map[string]any, error) {
+			require.Equal(t, []any{"app", "orders"}, req.Args)
+
+			if w.columns == nil {
+				return nil, nil
+			}
+
+			return rows(), nil
+		}
+	}
+
+	w.FakeWorkspace = testutil.NewFakeWorkspace(t, testutil.StatementHandlers{
+		{
+			Pattern: regexp.MustCompile("^CREATE TABLE `orders` "),
+			Exec: exec(func(_ []string) error {
+				if w.columns != nil {
+					return fmt.Errorf("table orders already exists")
+				}
+
+				w.columns = []map[string]any{
+					fakeColumn("order_id", "BIGINT", false),
+					fakeColumn("amount", "DECIMAL(10, 2)", true),
+				}
+
+				return nil
+			}),
+		},
+		{
+			Pattern: testutil.Exactly("DROP TABLE IF EXISTS `orders`"),
+			Exec: exec(func(_ []string) error {
+				w.columns = nil
+
+				return nil
+			}),
+		},
+		{
+			Pattern: addColumnRegexp,
+			Exec: exec(func(m []string) error {
+				i := slices.IndexFunc(w.columns, func(c map[string]any) bool { return c["COLUMN_NAME"] == m[4] })
+				w.columns = slices.Insert(w.columns, i+1, fakeColumn(m[1], m[2], m[3] == ""))
+
+				return nil
+			}),
+		},
+		{
+			Pattern: dropColumnRegexp,
+			Exec: exec(func(m []string) error {
+				w.columns = slices.DeleteFunc(w.columns, func(c map[string]any) bool { return c["COLUMN_NAME"] == m[1] })
+
+				return nil
+			}),
+		},
+		{
+			Pattern: regexp.MustCompile("^" + regexp.QuoteMeta("SELECT STORAGE_TYPE FROM information_schema.TABLES")),
+			Query: query(func() []map[string]any {
+				return []map[string]any{{"STORAGE_TYPE": "COLUMNSTORE"}}
+			}),
+		},
+		{
+			Pattern: regexp.MustCompile(regexp.QuoteMeta("FROM information_schema.COLUMNS")),
+			Query: query(func() []map[string]any {
+				return slices.Clone(w.columns)
+			}),
+		},
+		{
+			Pattern: regexp.MustCompile(regexp.QuoteMeta("FROM information_schema.STATISTICS")),
+			Query: query(func() []map[string]any {
+				return []map[string]any{
+					{"INDEX_NAME": "__SHARDKEY", "NON_UNIQUE": 1, "COLUMN_NAME": "order_id", "INDEX_TYPE": "METADATA_ONLY"},
+					{"INDEX_NAME": "order_id", "NON_UNIQUE": 1, "COLUMN_NAME": "order_id", "INDEX_TYPE": "CLUSTERED COLUMNSTORE"},
+				}
+			}),
+		},
+	})
+
+	return w
+}
+
+func fakeColumn(name, columnType string, nullable bool) map[string]any {
+	return map[string]any{
+		"COLUMN_NAME":    name,
+		"COLUMN_TYPE":    engineTypes[columnType],
+		"IS_NULLABLE":    map[bool]string{true: "YES", false: "NO"}[nullable],
+		"COLUMN_DEFAULT": nil,
+		"NO_DEFAULT":     1,
+	}
+}
+
+func (w *fakeTables) dropColumnOutsideTerraform(name string) {
+	w.Do(func() {
+		w.columns = slices.DeleteFunc(w.columns, func(c map[string]any) bool { return c["COLUMN_NAME"] == name })
+	})
+}
+
+func tableConfig(columns ...string) string {
+	return fmt.Sprintf(`
+provider "singlestoredb" {
+}
+
+resource "singlestoredb_table" "this" {
+  endpoint = %q
+  password = "secret"
+  database = "app"
+  name     = "orders"
+
+  columns = [
+    { name = "order_id", type = "BIGINT", nullable = false },
+    { name = "amount", type = "DECIMAL(10, 2)" },
+    %s
+  ]
+
+  shard_key = ["order_id"]
+  sort_key  = ["order_id"]
+}
+`, testutil.TestWorkspaceEndpoint, strings.Join(columns, ",\n    "))
+}
+
+func TestCRUDTable(t *testing.T) {
+	w := newFakeTables(t)
+	t.Setenv(config.EnvSQLUserPassword, "secret") // The imported table reads the password from the environment.
+
+	const note = `{ name = "note", type = "TEXT" }`
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: tableConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_table.this", config.IDAttribute, testutil.TestWorkspaceEndpoint+"/app/orders"),
+					resource.TestCheckResourceAttr("singlestoredb_table.this", "storage", "COLUMNSTORE"),
+					resource.TestCheckResourceAttr("singlestoredb_table.this", "columns.1.type", "DECIMAL(10, 2)"),
+					resource.TestCheckResourceAttr("singlestoredb_table.this", "columns.1.nullable", "true"),
+				),
+			},
+			{
+				Config: tableConfig(note),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_table.this", "columns.#", "3"),
+				),
+			},
+			{
+				// A column dropped outside of Terraform is added again.
+				PreConfig: func() {
+					w.dropColumnOutsideTerraform("note")
+				},
+				Config: tableConfig(note),
+			},
+			{
+				ResourceName:      "singlestoredb_table.this",
+				ImportState:       true,
+				ImportStateId:     testutil.TestWorkspaceEndpoint + "/app/orders",
+				ImportStateVerify: true,
+				// The workspace reports the types in its own spelling.
+				ImportStateVerifyIgnore: []string{"password", "columns.0.type", "columns.1.type", "columns.2.type"},
+			},
+			{
+				Config: tableConfig(),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE `orders` (`order_id` BIGINT NOT NULL, `amount` DECIMAL(10, 2), SHARD KEY (`order_id`), SORT KEY (`order_id`))",
+		"ALTER TABLE `orders` ADD COLUMN `note` TEXT AFTER `amount`",
+		"ALTER TABLE `orders` ADD COLUMN `note` TEXT AFTER `amount`",
+		"ALTER TABLE `orders` DROP COLUMN `note`",
+		"DROP TABLE IF EXISTS `orders`",
+	}, w.Executed())
+}
+
+func TestTableDroppedOutsideOfTerraform(t *testing.T) {
+	w := newFakeTables(t)
+
+	testutil.UnitTest(t, w.UnitTestConfig(), resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: tableConfig(),
+			},
+			{
+				PreConfig: func() {
+					w.Do(func() {
+						w.columns = nil
+					})
+				},
+				Config: tableConfig(),
+			},
+		},
+	})
+
+	require.Equal(t, []string{
+		"CREATE TABLE `orders` (`order_id` BIGINT NOT NULL, `amount` DECIMAL(10, 2), SHARD KEY (`order_id`), SORT KEY (`order_id`))",
+		"CREATE TABLE `orders` (`order_id` BIGINT NOT NULL, `amount` DECIMAL(10, 2), SHARD KEY (`order_id`), SORT KEY (`order_id`))",
+		"DROP TABLE IF EXISTS `orders`",
+	}, w.Executed())
+}
+
+func TestTableResourceIntegration(t *testing.T) {
+	testutil.IntegrationTest(t, testutil.IntegrationTestConfig{
+		APIKey:             os.Getenv(config.EnvTestAPIKey),
+		WorkspaceGroupName: "example",
+	}, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testutil.UpdatableConfig(examples.TableResource).
+					WithWorkspaceGroupResource("example")("admin_password", cty.StringVal(testutil.TestAdminPassword)).
+					String(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("singlestoredb_table.orders", "storage", "COLUMNSTORE"),
+					resource.TestCheckResourceAttr("singlestoredb_table.orders", "columns.#", "7"),
+					resource.TestCheckResourceAttr("singlestoredb_table.orders", "indexes.#", "3"),
+				),
+			},
+		},
+	})
+}
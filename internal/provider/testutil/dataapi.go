@@ -0,0 +1,236 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+
+	singlestoresql "github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	DataAPIExecPath  = "/api/v2/exec"
+	DataAPIQueryPath = "/api/v2/query/tuples"
+	// TestWorkspaceEndpoint is the SQL endpoint of the mock workspaces of the unit tests.
+	TestWorkspaceEndpoint = "workspace.example.com"
+	// TestAdminPassword is the admin password of the workspace groups of the integration tests.
+	TestAdminPassword = "sfkjDIJ423d44w1sfooBar1$" //nolint:gosec
+)
+
+// DataAPIRequest is a statement received by the mock Data API.
+type DataAPIRequest = singlestoresql.ExecRequest
+
+// DataAPIHandler answers the Data API requests of a unit test.
+// Returning an error responds with the error message as a failed statement.
+type DataAPIHandler struct {
+	Exec  func(req DataAPIRequest) error
+	Query func(req DataAPIRequest) ([]map[string]any, error)
+}
+
+// StatementHandler answers the statements that match Pattern. Exec answers them on the exec endpoint
+// and Query on the query endpoint; both receive the submatches of Pattern.
+type StatementHandler struct {
+	Pattern *regexp.Regexp
+	Exec    func(req DataAPIRequest, m []string) error
+	Query   func(req DataAPIRequest, m []string) ([]map[string]any, error)
+}
+
+// StatementHandlers is a table of statement handlers that serves the Data API of a mock workspace.
+// Each request is answered by the first handler that matches the statement and handles its endpoint,
+// and statements that no handler answers fail as unexpected.
+type StatementHandlers []StatementHandler
+
+// Exactly returns a pattern that matches the statement literally.
+func Exactly(statement string) *regexp.Regexp {
+	return regexp.MustCompile("^" + regexp.QuoteMeta(statement) + "$")
+}
+
+func (hs StatementHandlers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	DataAPIHandler{
+		Exec: func(req DataAPIRequest) error {
+			for _, h := range hs {
+				if m := h.Pattern.FindStringSubmatch(req.SQL); m != nil && h.Exec != nil {
+					return h.Exec(req, m)
+				}
+			}
+
+			return fmt.Errorf("unexpected statement %q", req.SQL)
+		},
+		Query: func(req DataAPIRequest) ([]map[string]any, error) {
+			for _, h := range hs {
+				if m := h.Pattern.FindStringSubmatch(req.SQL); m != nil && h.Query != nil {
+					return h.Query(req, m)
+				}
+			}
+
+			return nil, fmt.Errorf("unexpected query %q", req.SQL)
+		},
+	}.ServeHTTP(w, r)
+}
+
+// MockDataAPIServer starts a local server with the handler and returns the factory of HTTP clients that
+// redirect the Data API requests to it. Pass it as UnitTestConfig.DataAPIHTTPClient.
+func MockDataAPIServer(t *testing.T, handler http.Handler) func() *http.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	target, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	return func() *http.Client {
+		return &http.Client{
+			Transport: redirectTransport{target: target},
+		}
+	}
+}
+
+// FakeWorkspace serves the Data API of a mock workspace in unit tests.
+// The handler runs under the lock of the workspace, so it may use the state of the test freely,
+// and the test changes that state between the steps with Do.
+type FakeWorkspace struct {
+	mu            sync.Mutex
+	statements    []string
+	newHTTPClient func() *http.Client
+}
+
+// NewFakeWorkspace starts a mock Data API server with the handler, typically StatementHandlers.
+func NewFakeWorkspace(t *testing.T, handler http.Handler) *FakeWorkspace {
+	t.Helper()
+
+	w := &FakeWorkspace{}
+	w.newHTTPClient = MockDataAPIServer(t, http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		handler.ServeHTTP(rw, r)
+	}))
+
+	return w
+}
+
+// UnitTestConfig returns the configuration of a unit test that serves the Data API with the workspace.
+func (w *FakeWorkspace) UnitTestConfig() UnitTestConfig {
+	return UnitTestConfig{
+		APIKey:            UnusedAPIKey,
+		DataAPIHTTPClient: w.newHTTPClient,
+	}
+}
+
+// Do runs f under the lock of the workspace, e.g., to change it outside of Terraform.
+func (w *FakeWorkspace) Do(f func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	f()
+}
+
+// Record records a statement that ran. Only the handler calls it, under the lock of the workspace.
+func (w *FakeWorkspace) Record(statement string) {
+	w.statements = append(w.statements, statement)
+}
+
+// Executed returns the recorded statements.
+func (w *FakeWorkspace) Executed() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return slices.Clone(w.statements)
+}
+
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (rt redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	cloned := req.Clone(req.Context())
+	cloned.URL.Scheme = rt.target.Scheme
+	cloned.URL.Host = rt.target.Host
+
+	return http.DefaultTransport.RoundTrip(cloned)
+}
+
+func (h DataAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req DataAPIRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	var response any
+
+	switch r.URL.Path {
+	case DataAPIExecPath:
+		var err error
+		if h.Exec != nil {
+			err = h.Exec(req)
+		}
+
+		if err != nil {
+			response = map[string]any{"error": map[string]any{"message": err.Error()}}
+		} else {
+			response = map[string]any{"lastInsertId": 0, "rowsAffected": 0}
+		}
+	case DataAPIQueryPath:
+		var rows []map[string]any
+		var err error
+		if h.Query != nil {
+			rows, err = h.Query(req)
+		}
+
+		if rows == nil {
+			rows = []map[string]any{}
+		}
+
+		if err != nil {
+			response = map[string]any{"error": map[string]any{"message": err.Error()}}
+		} else {
+			response = map[string]any{"results": []any{tupleResultSet(rows)}}
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// tupleResultSet encodes rows like /api/v2/query/tuples, with the column names in alphabetical order and without SQL types.
+func tupleResultSet(rows []map[string]any) map[string]any {
+	names := map[string]bool{}
+	for _, row := range rows {
+		for name := range row {
+			names[name] = true
+		}
+	}
+
+	columns := []map[string]any{}
+	sorted := slices.Sorted(maps.Keys(names))
+	for _, name := range sorted {
+		columns = append(columns, map[string]any{"name": name, "nullable": true})
+	}
+
+	tuples := make([][]any, 0, len(rows))
+	for _, row := range rows {
+		tuple := make([]any, len(sorted))
+		for i, name := range sorted {
+			tuple[i] = row[name]
+		}
+
+		tuples = append(tuples, tuple)
+	}
+
+	return map[string]any{"columns": columns, "rows": tuples}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
@@ -36,6 +37,8 @@ type UnitTestConfig struct {
 	APIKeyFromEnv string
 	APIKey        string
 	APIServiceURL string
+	// DataAPIHTTPClient creates the HTTP clients of the Data API, e.g., the one returned by MockDataAPIServer.
+	DataAPIHTTPClient func() *http.Client
 }
 
 type IntegrationTestConfig struct {
@@ -61,7 +64,12 @@ func UnitTest(t *testing.T, conf UnitTestConfig, c resource.TestCase) {
 			String()
 	}
 
-	f := provider.New(devVersion)
+	var opts []provider.Option
+	if conf.DataAPIHTTPClient != nil {
+		opts = append(opts, provider.WithDataAPIHTTPClient(conf.DataAPIHTTPClient))
+	}
+
+	f := provider.New(devVersion, opts...)
 	c.ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		config.ProviderName: providerserver.NewProtocol6WithError(f()),
 	}
@@ -65,6 +65,14 @@ func MaybeBool(b types.Bool) *bool {
 	return Ptr(b.ValueBool())
 }
 
+func MaybeInt64(i types.Int64) *int64 {
+	if i.IsNull() || i.IsUnknown() {
+		return nil
+	}
+
+	return Ptr(i.ValueInt64())
+}
+
 func MaybeBoolValue(b *bool) types.Bool {
 	return maybeElse(b, types.BoolValue, types.BoolNull)
 }
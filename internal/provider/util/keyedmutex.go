@@ -0,0 +1,157 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// KeyedMutex serializes operations that share a key while operations on different keys run concurrently.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sem    chan struct{}
+	holder string
+	refs   int
+}
+
+// NewKeyedMutex creates an empty KeyedMutex.
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{locks: map[string]*keyedLock{}}
+}
+
+// Lock blocks until the key is free and acquires it on behalf of the operation.
+//
+// If the key is held by another operation, onWait is called with the description of that operation before blocking.
+// The returned unlock function releases the key; calling it more than once has no effect.
+func (m *KeyedMutex) Lock(ctx context.Context, key, operation string, onWait func(holder string)) (func(), error) {
+	m.mu.Lock()
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{sem: make(chan struct{}, 1)}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	select {
+	case l.sem <- struct{}{}:
+	default:
+		if onWait != nil {
+			m.mu.Lock()
+			holder := l.holder
+			m.mu.Unlock()
+
+			onWait(holder)
+		}
+
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			m.release(key, l)
+
+			return nil, ctx.Err()
+		}
+	}
+
+	m.mu.Lock()
+	l.holder = operation
+	m.mu.Unlock()
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			m.mu.Lock()
+			l.holder = ""
+			m.mu.Unlock()
+
+			<-l.sem
+			m.release(key, l)
+		})
+	}, nil
+}
+
+func (m *KeyedMutex) release(key string, l *keyedLock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l.refs--
+	if l.refs == 0 {
+		delete(m.locks, key)
+	}
+}
+
+// workspaceGroupLocks is shared by all the resources of the provider.
+var workspaceGroupLocks = NewKeyedMutex()
+
+// plannedGroupOperations records the mutating operations planned per workspace group
+// since the provider was last configured.
+var plannedGroupOperations = struct {
+	sync.Mutex
+	byGroup map[string][]string
+}{byGroup: map[string][]string{}}
+
+// LockWorkspaceGroup serializes mutating operations on workspaces, private connections, and Flow instances
+// within the same workspace group, because the Management API rejects an operation while the group is busy
+// with another one. Operations in different workspace groups run concurrently.
+//
+// The caller should defer the returned unlock function.
+func LockWorkspaceGroup(ctx context.Context, workspaceGroupID, operation string) (func(), *SummaryWithDetailError) {
+	unlock, err := workspaceGroupLocks.Lock(ctx, workspaceGroupID, operation, func(holder string) {
+		tflog.Info(ctx, fmt.Sprintf("%s is waiting for %s to complete in the workspace group %s", operation, holder, workspaceGroupID))
+	})
+	if err != nil {
+		return nil, &SummaryWithDetailError{
+			Summary: fmt.Sprintf("Failed to wait for the workspace group %s", workspaceGroupID),
+			Detail:  fmt.Sprintf("%s did not start because another operation in the workspace group %s did not complete in time: %s", operation, workspaceGroupID, err),
+		}
+	}
+
+	return unlock, nil
+}
+
+// ResetPlannedWorkspaceGroupOperations forgets the planned operations. The provider calls it when it is
+// configured, which Terraform does at the start of every plan and apply, so that the operations of
+// a previous run are not reported.
+func ResetPlannedWorkspaceGroupOperations() {
+	plannedGroupOperations.Lock()
+	defer plannedGroupOperations.Unlock()
+
+	clear(plannedGroupOperations.byGroup)
+}
+
+// PlanWorkspaceGroupOperation records an operation planned in the workspace group and logs at plan time
+// that LockWorkspaceGroup will serialize it during apply with the other operations planned in the same group.
+// The order in which they run is only known during apply.
+//
+// It returns the other operations. Unknown workspace groups are ignored,
+// e.g., the group of a workspace whose group is created by the same apply.
+func PlanWorkspaceGroupOperation(ctx context.Context, workspaceGroupID types.String, operation string) []string {
+	if !IsConfiguredString(workspaceGroupID) {
+		return nil
+	}
+
+	id := workspaceGroupID.ValueString()
+
+	plannedGroupOperations.Lock()
+	planned := plannedGroupOperations.byGroup[id]
+	if !Any(planned, operation) {
+		plannedGroupOperations.byGroup[id] = append(planned, operation)
+	}
+	plannedGroupOperations.Unlock()
+
+	others := Filter(planned, func(o string) bool { return o != operation })
+	if len(others) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("%s will not run concurrently with %s in the workspace group %s; one of them will wait during apply", operation, strings.Join(others, ", "), id))
+	}
+
+	return others
+}
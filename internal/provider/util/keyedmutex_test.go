@@ -0,0 +1,88 @@
+package util_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyedMutexSerializesSameKey(t *testing.T) {
+	m := util.NewKeyedMutex()
+
+	unlock, err := m.Lock(t.Context(), "group", "first", nil)
+	require.NoError(t, err)
+
+	var waitedFor string
+	acquired := make(chan struct{})
+	go func() {
+		unlockSecond, err := m.Lock(t.Context(), "group", "second", func(holder string) { waitedFor = holder })
+		if err == nil {
+			unlockSecond()
+		}
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("the second operation should wait for the first one")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	unlock()
+	unlock() // Unlocking twice has no effect.
+
+	<-acquired
+	require.Equal(t, "first", waitedFor)
+}
+
+func TestKeyedMutexDifferentKeysRunConcurrently(t *testing.T) {
+	m := util.NewKeyedMutex()
+
+	unlock, err := m.Lock(t.Context(), "group-1", "first", nil)
+	require.NoError(t, err)
+	defer unlock()
+
+	var waited atomic.Bool
+	unlockOther, err := m.Lock(t.Context(), "group-2", "second", func(string) { waited.Store(true) })
+	require.NoError(t, err)
+	unlockOther()
+	require.False(t, waited.Load())
+}
+
+func TestKeyedMutexContextCanceled(t *testing.T) {
+	m := util.NewKeyedMutex()
+
+	unlock, err := m.Lock(t.Context(), "group", "first", nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = m.Lock(ctx, "group", "second", nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	unlock()
+
+	unlock, err = m.Lock(t.Context(), "group", "third", nil)
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestPlanWorkspaceGroupOperation(t *testing.T) {
+	group := types.StringValue(uuid.NewString())
+
+	require.Empty(t, util.PlanWorkspaceGroupOperation(t.Context(), group, "Creating the workspace a"))
+	require.Equal(t, []string{"Creating the workspace a"}, util.PlanWorkspaceGroupOperation(t.Context(), group, "Creating the workspace b"))
+	require.Equal(t, []string{"Creating the workspace b"}, util.PlanWorkspaceGroupOperation(t.Context(), group, "Creating the workspace a"), "planning again should not wait for itself")
+	require.Empty(t, util.PlanWorkspaceGroupOperation(t.Context(), types.StringValue(uuid.NewString()), "Creating the workspace c"))
+	require.Empty(t, util.PlanWorkspaceGroupOperation(t.Context(), types.StringUnknown(), "Creating the workspace d"))
+
+	util.ResetPlannedWorkspaceGroupOperations()
+	require.Empty(t, util.PlanWorkspaceGroupOperation(t.Context(), group, "Creating the workspace b"), "the operations of a previous run should be forgotten")
+}
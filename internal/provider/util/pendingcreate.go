@@ -0,0 +1,96 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+// createPendingKey marks in the private state a resource that was created but did not become ready.
+const createPendingKey = "create_pending"
+
+// PrivateState is the private state of resource requests and responses.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// SavePendingCreate saves the plan with the ID of the created resource as the state and marks the creation pending.
+//
+// Creating workspaces and workspace groups takes a long time. If the wait for the resource to become ready is interrupted,
+// the saved state lets the next apply continue waiting instead of creating a duplicate resource.
+// The values that are unknown until the resource is ready are saved as nulls.
+func SavePendingCreate(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := tftypes.Transform(req.Plan.Raw, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if v.IsKnown() {
+			return v, nil
+		}
+
+		return tftypes.NewValue(v.Type(), nil), nil
+	})
+	if err != nil {
+		diags.AddError("Failed to save the partial state", config.CreateProviderIssueErrorDetail+"\n\n"+err.Error())
+
+		return diags
+	}
+
+	resp.State.Raw = raw
+	diags.Append(resp.State.SetAttribute(ctx, path.Root(config.IDAttribute), id)...)
+	diags.Append(resp.Private.SetKey(ctx, createPendingKey, []byte("true"))...)
+
+	return diags
+}
+
+// IsCreatePending reports whether the resource was created but the wait for it to become ready was interrupted.
+func IsCreatePending(ctx context.Context, private PrivateState) bool {
+	value, diags := private.GetKey(ctx, createPendingKey)
+
+	return !diags.HasError() && string(value) == "true"
+}
+
+// ClearCreatePending marks the creation of the resource complete.
+func ClearCreatePending(ctx context.Context, private PrivateState) diag.Diagnostics {
+	return private.SetKey(ctx, createPendingKey, nil)
+}
+
+// AddCreateWaitDiagnostic reports a failed wait for a created resource.
+//
+// If the wait was interrupted, e.g., by cancelling the apply, or timed out, it adds a warning since the state is saved
+// with the creation pending, and the next plan continues waiting. Otherwise, e.g., if the resource failed,
+// it adds an error and Terraform marks the resource as tainted, so that it is replaced.
+func AddCreateWaitDiagnostic(ctx context.Context, diags *diag.Diagnostics, resourceName, id string, werr *SummaryWithDetailError) {
+	var timeout *PollTimeoutError
+	if ctx.Err() == nil && !errors.As(werr, &timeout) {
+		diags.AddError(werr.Summary, werr.Detail)
+
+		return
+	}
+
+	diags.AddWarning(
+		fmt.Sprintf("The %s %s is created, but is not ready yet", resourceName, id),
+		fmt.Sprintf("Waiting for the %s was interrupted or timed out. The next apply continues waiting for the %s instead of creating it again.\n\n%s",
+			resourceName, resourceName, werr.Detail,
+		),
+	)
+}
+
+// PlanPendingCreate marks the computed string attributes unknown while the creation is pending,
+// so that Terraform plans an update that continues waiting for the resource.
+func PlanPendingCreate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes ...string) {
+	if !IsCreatePending(ctx, req.Private) {
+		return
+	}
+
+	for _, name := range attributes {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+}
@@ -0,0 +1,71 @@
+package util_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+type privateState map[string][]byte
+
+func (p privateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p privateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+
+	return nil
+}
+
+func TestIsCreatePending(t *testing.T) {
+	private := privateState{"create_pending": []byte("true")}
+	require.True(t, util.IsCreatePending(t.Context(), private))
+
+	require.False(t, util.ClearCreatePending(t.Context(), private).HasError())
+	require.False(t, util.IsCreatePending(t.Context(), private))
+}
+
+func TestAddCreateWaitDiagnostic(t *testing.T) {
+	werr := &util.SummaryWithDetailError{Summary: "Failed to wait", Detail: "not ready"}
+
+	t.Run("failure is an error", func(t *testing.T) {
+		var diags diag.Diagnostics
+		util.AddCreateWaitDiagnostic(t.Context(), &diags, "workspace", "id", werr)
+		require.True(t, diags.HasError())
+		require.Equal(t, "Failed to wait", diags[0].Summary())
+	})
+
+	t.Run("interruption is a warning", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		var diags diag.Diagnostics
+		util.AddCreateWaitDiagnostic(ctx, &diags, "workspace", "id", werr)
+		require.False(t, diags.HasError())
+		require.Equal(t, 1, diags.WarningsCount())
+		require.Contains(t, diags[0].Detail(), "continues waiting")
+	})
+
+	t.Run("timeout is a warning", func(t *testing.T) {
+		timeout := &util.SummaryWithDetailError{
+			Summary: "Failed to wait",
+			Detail:  "not ready",
+			Err:     &util.PollTimeoutError{Description: "workspace id", Timeout: time.Minute, LastErr: errors.New("state is PENDING")},
+		}
+
+		var diags diag.Diagnostics
+		util.AddCreateWaitDiagnostic(t.Context(), &diags, "workspace", "id", timeout)
+		require.False(t, diags.HasError())
+		require.Equal(t, 1, diags.WarningsCount())
+	})
+}
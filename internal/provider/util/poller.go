@@ -0,0 +1,209 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
+)
+
+// PollCondition returns nil if it is satisfied.
+type PollCondition[T any] func(T) error
+
+// Poller waits until an object fetched from the Management API satisfies all the conditions.
+//
+// The delay between polls starts at MinInterval and doubles, with jitter, up to MaxInterval
+// for as long as the object does not change. Any change of the observed progress resets the
+// delay, so that state transitions are confirmed quickly while long creations do not spam the API.
+type Poller[T any] struct {
+	// Description names the polled object in logs and errors, e.g., "workspace <id>".
+	Description string
+	// Timeout limits the total polling time.
+	Timeout time.Duration
+	// ConsistencyWindow is the count of consecutive polls that should all satisfy the conditions.
+	// The Management API is eventually consistent, so a single satisfying response is not enough.
+	ConsistencyWindow int
+	// MinInterval is the initial delay between polls. Defaults to config.PollMinInterval.
+	MinInterval time.Duration
+	// MaxInterval caps the delay between polls. Defaults to config.PollMaxInterval.
+	MaxInterval time.Duration
+	// Get fetches the object. Errors are retried unless wrapped with StopPolling.
+	// Get may also return *TerminalStateError if detecting the state needs more than the object.
+	Get func(ctx context.Context) (T, error)
+	// Terminal detects states that the object never leaves, e.g., FAILED.
+	Terminal func(T) *TerminalStateError
+	// Conditions should all be satisfied for the polling to succeed.
+	Conditions []PollCondition[T]
+}
+
+// TerminalStateError reports that the polled object reached a state it never leaves.
+type TerminalStateError struct {
+	State string
+	Err   error
+}
+
+func (e *TerminalStateError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TerminalStateError) Unwrap() error {
+	return e.Err
+}
+
+// PollTimeoutError reports that the conditions were not satisfied within the timeout.
+type PollTimeoutError struct {
+	Description string
+	Timeout     time.Duration
+	LastErr     error
+}
+
+func (e *PollTimeoutError) Error() string {
+	return fmt.Sprintf("timeout while waiting for %s (timeout: %s, last error: %s)", e.Description, e.Timeout, e.LastErr)
+}
+
+func (e *PollTimeoutError) Unwrap() error {
+	return e.LastErr
+}
+
+type stopPollingError struct {
+	err error
+}
+
+func (e *stopPollingError) Error() string {
+	return e.err.Error()
+}
+
+// StopPolling marks an error of Poller.Get as non-retryable.
+func StopPolling(err error) error {
+	return &stopPollingError{err: err}
+}
+
+// Poll waits until the object satisfies all the conditions for ConsistencyWindow consecutive polls.
+//
+// It returns *TerminalStateError if the object reached a terminal state,
+// *PollTimeoutError if the timeout elapsed, the error passed to StopPolling,
+// or the context error.
+func (p Poller[T]) Poll(ctx context.Context) (T, error) {
+	var zero T
+
+	minInterval := p.MinInterval
+	if minInterval <= 0 {
+		minInterval = config.PollMinInterval
+	}
+
+	maxInterval := p.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = config.PollMaxInterval
+	}
+
+	maxInterval = max(maxInterval, minInterval)
+
+	window := max(p.ConsistencyWindow, 1)
+	begin := time.Now()
+	deadline := begin.Add(p.Timeout)
+	interval := minInterval
+	consecutive := 0
+	lastReason := ""
+
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		value, reason := p.poll(ctx)
+
+		var stop *stopPollingError
+		if errors.As(reason, &stop) {
+			return zero, stop.err
+		}
+
+		var terminal *TerminalStateError
+		if errors.As(reason, &terminal) {
+			return zero, terminal
+		}
+
+		if reason == nil {
+			consecutive++
+			if consecutive >= window {
+				tflog.Debug(ctx, fmt.Sprintf("%s is ready", p.Description), map[string]any{
+					"attempts": attempt,
+					"elapsed":  time.Since(begin).Round(time.Second).String(),
+				})
+
+				return value, nil
+			}
+
+			reason = fmt.Errorf("%s satisfies the conditions but not for the consequent %d iterations yet",
+				p.Description, window,
+			)
+			interval = minInterval
+		} else {
+			consecutive = 0
+
+			if reason.Error() == lastReason {
+				interval = min(2*interval, maxInterval)
+			} else {
+				interval = minInterval
+				tflog.Info(ctx, fmt.Sprintf("waiting for %s: %s", p.Description, reason), map[string]any{
+					"elapsed": time.Since(begin).Round(time.Second).String(),
+				})
+			}
+
+			lastReason = reason.Error()
+		}
+
+		lastErr = reason
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return zero, &PollTimeoutError{Description: p.Description, Timeout: p.Timeout, LastErr: lastErr}
+		}
+
+		delay := min(withJitter(interval), remaining)
+
+		tflog.Debug(ctx, fmt.Sprintf("polling %s", p.Description), map[string]any{
+			"attempt":      attempt,
+			"elapsed":      time.Since(begin).Round(time.Second).String(),
+			"next_poll_in": delay.Round(time.Millisecond).String(),
+			"reason":       reason.Error(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return zero, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (p Poller[T]) poll(ctx context.Context) (T, error) {
+	value, err := p.Get(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	if p.Terminal != nil {
+		if terr := p.Terminal(value); terr != nil {
+			return value, terr
+		}
+	}
+
+	for _, c := range p.Conditions {
+		if err := c(value); err != nil {
+			return value, err
+		}
+	}
+
+	return value, nil
+}
+
+func withJitter(d time.Duration) time.Duration {
+	factor := 1 + config.PollJitter*(2*rand.Float64()-1) //nolint:gosec // Jitter does not need a cryptographically secure source.
+
+	return time.Duration(float64(d) * factor)
+}
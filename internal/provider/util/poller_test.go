@@ -0,0 +1,119 @@
+package util_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+func testPoller(states ...string) (*util.Poller[string], *int) {
+	calls := 0
+
+	return &util.Poller[string]{
+		Description:       "object",
+		Timeout:           time.Second,
+		ConsistencyWindow: 3,
+		MinInterval:       time.Millisecond,
+		MaxInterval:       2 * time.Millisecond,
+		Get: func(context.Context) (string, error) {
+			state := states[min(calls, len(states)-1)]
+			calls++
+
+			return state, nil
+		},
+		Terminal: func(state string) *util.TerminalStateError {
+			if state != "FAILED" {
+				return nil
+			}
+
+			return &util.TerminalStateError{State: state, Err: errors.New("object failed")}
+		},
+		Conditions: []util.PollCondition[string]{
+			func(state string) error {
+				if state != "ACTIVE" {
+					return fmt.Errorf("state is %s", state)
+				}
+
+				return nil
+			},
+		},
+	}, &calls
+}
+
+func TestPollWaitsForConsistencyWindow(t *testing.T) {
+	poller, calls := testPoller("PENDING", "ACTIVE", "PENDING", "ACTIVE")
+
+	result, err := poller.Poll(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", result)
+	require.Equal(t, 6, *calls, "a flap back to PENDING should restart the consistency window")
+}
+
+func TestPollTerminalState(t *testing.T) {
+	poller, calls := testPoller("PENDING", "FAILED")
+
+	_, err := poller.Poll(t.Context())
+
+	var terminal *util.TerminalStateError
+	require.ErrorAs(t, err, &terminal)
+	require.Equal(t, "FAILED", terminal.State)
+	require.ErrorContains(t, err, "object failed")
+	require.Equal(t, 2, *calls)
+}
+
+func TestPollRetriesGetErrors(t *testing.T) {
+	poller, _ := testPoller("ACTIVE")
+	get := poller.Get
+	failures := 2
+	poller.Get = func(ctx context.Context) (string, error) {
+		if failures > 0 {
+			failures--
+
+			return "", errors.New("service unavailable")
+		}
+
+		return get(ctx)
+	}
+
+	result, err := poller.Poll(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", result)
+}
+
+func TestPollStopPolling(t *testing.T) {
+	poller, _ := testPoller("ACTIVE")
+	cause := errors.New("client error")
+	poller.Get = func(context.Context) (string, error) {
+		return "", util.StopPolling(cause)
+	}
+
+	_, err := poller.Poll(t.Context())
+	require.ErrorIs(t, err, cause)
+}
+
+func TestPollTimeout(t *testing.T) {
+	poller, _ := testPoller("PENDING")
+	poller.Timeout = 20 * time.Millisecond
+
+	_, err := poller.Poll(t.Context())
+
+	var timeout *util.PollTimeoutError
+	require.ErrorAs(t, err, &timeout)
+	require.ErrorContains(t, err, "state is PENDING")
+}
+
+func TestPollContextCanceled(t *testing.T) {
+	poller, _ := testPoller("PENDING")
+	poller.Timeout = time.Hour
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := poller.Poll(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,16 @@
+package util
+
+import (
+	"net/http"
+
+	"github.com/singlestore-labs/singlestore-go/management"
+)
+
+// ProviderData is passed by the provider to the Configure methods of the resources and data sources.
+// It embeds the Management API client, which most of them use as the provider data,
+// and configures the HTTP client of the Data API for the ones that run SQL.
+type ProviderData struct {
+	management.ClientWithResponsesInterface
+	// DataAPIHTTPClient creates the HTTP client of each Data API client. Defaults to NewHTTPClient.
+	DataAPIHTTPClient func() *http.Client
+}
@@ -69,18 +69,6 @@ func TestMapWithError(t *testing.T) {
 	}
 }
 
-func TestCheckLastN(t *testing.T) {
-	require.False(t, util.CheckLastN([]string{}, 10, "foo"))
-	require.True(t, util.CheckLastN([]string{}, 0, "foo"))
-	require.False(t, util.CheckLastN([]string{"bar"}, 1, "foo"))
-	require.True(t, util.CheckLastN([]string{"foo"}, 1, "foo"))
-	require.False(t, util.CheckLastN([]string{"foo", "bar"}, 1, "foo"))
-	require.True(t, util.CheckLastN([]string{"foo", "bar"}, 1, "foo", "bar"))
-	require.False(t, util.CheckLastN([]string{"bar", "foo"}, 2, "foo"))
-	require.True(t, util.CheckLastN([]string{"bar", "foo", "foo"}, 2, "foo"))
-	require.False(t, util.CheckLastN([]string{"foo", "bar", "foo"}, 2, "foo"))
-}
-
 func TestReadNotEmptyFileTrimmed(t *testing.T) {
 	_, err := util.ReadNotEmptyFileTrimmed("/no/such/path/for/sure.txt")
 	require.Error(t, err)
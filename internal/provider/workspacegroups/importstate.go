@@ -0,0 +1,78 @@
+package workspacegroups
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// importIDNamePrefix marks an import ID that refers to a workspace group by its name.
+const importIDNamePrefix = "name:"
+
+// resolveImportID maps an import ID onto the workspace group ID.
+//
+// The import ID is either the workspace group UUID, "name:<group>", or "<project>/<group>".
+// Names are resolved through the list endpoint the same way the data source resolves them.
+func resolveImportID(ctx context.Context, c management.ClientWithResponsesInterface, importID string) (string, *util.SummaryWithDetailError) {
+	if _, err := uuid.Parse(importID); err == nil {
+		return importID, nil
+	}
+
+	var projectName, groupName string
+	if name, ok := strings.CutPrefix(importID, importIDNamePrefix); ok {
+		groupName = name
+	} else if project, name, ok := strings.Cut(importID, "/"); ok {
+		projectName, groupName = project, name
+	}
+
+	if strings.TrimSpace(groupName) == "" {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Invalid import ID",
+			Detail: fmt.Sprintf("The provided import ID \"%s\" is neither a valid UUID, nor in the form \"%s<workspace group name>\" or \"<project name>/<workspace group name>\".",
+				importID, importIDNamePrefix),
+		}
+	}
+
+	workspaceGroups, err := c.GetV1WorkspaceGroupsWithResponse(ctx, &management.GetV1WorkspaceGroupsParams{})
+	if serr := util.StatusOK(workspaceGroups, err); serr != nil {
+		return "", serr
+	}
+
+	result := util.Filter(util.Deref(workspaceGroups.JSON200), func(wg management.WorkspaceGroup) bool {
+		if wg.State == management.WorkspaceGroupStateTERMINATED {
+			return false
+		}
+
+		if projectName != "" && !strings.EqualFold(strings.TrimSpace(util.Deref(wg.ProjectName)), strings.TrimSpace(projectName)) {
+			return false
+		}
+
+		return strings.EqualFold(strings.TrimSpace(wg.Name), strings.TrimSpace(groupName))
+	})
+
+	qualifiedName := fmt.Sprintf("'%s'", groupName)
+	if projectName != "" {
+		qualifiedName = fmt.Sprintf("'%s' in the project '%s'", groupName, projectName)
+	}
+
+	if len(result) == 0 {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Workspace group not found",
+			Detail:  fmt.Sprintf("No workspace group with the name %s was found. Please verify that the name is correct and that the workspace group exists.", qualifiedName),
+		}
+	}
+
+	if len(result) > 1 {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Multiple workspace groups found",
+			Detail: fmt.Sprintf("Multiple workspace groups with the name %s were found: %s. Please import by the workspace group ID or qualify the name as \"<project name>/<workspace group name>\".",
+				qualifiedName, util.Join(util.Map(result, func(wg management.WorkspaceGroup) string { return wg.WorkspaceGroupID.String() }), ", ")),
+		}
+	}
+
+	return result[0].WorkspaceGroupID.String(), nil
+}
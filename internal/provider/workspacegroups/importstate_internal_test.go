@@ -0,0 +1,102 @@
+package workspacegroups
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+	"github.com/stretchr/testify/require"
+)
+
+type importMockClient struct {
+	management.ClientWithResponsesInterface
+	workspaceGroups []management.WorkspaceGroup
+	listCalls       int
+}
+
+func (m *importMockClient) GetV1WorkspaceGroupsWithResponse(_ context.Context, _ *management.GetV1WorkspaceGroupsParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspaceGroupsResponse, error) {
+	m.listCalls++
+
+	return &management.GetV1WorkspaceGroupsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200:      &m.workspaceGroups,
+	}, nil
+}
+
+func TestResolveImportID(t *testing.T) {
+	analyticsGroupID := uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce")
+	stagingGroupID := uuid.MustParse("3c0c0d99-3c09-45ac-a01f-5ab62afd35cf")
+	terminatedGroupID := uuid.MustParse("0aa1aff3-4092-4a0c-bf36-da54e85a4fdf")
+
+	client := &importMockClient{
+		workspaceGroups: []management.WorkspaceGroup{
+			{
+				WorkspaceGroupID: analyticsGroupID,
+				Name:             "group",
+				ProjectName:      util.Ptr("analytics"),
+				State:            management.WorkspaceGroupStateACTIVE,
+			},
+			{
+				WorkspaceGroupID: stagingGroupID,
+				Name:             "group",
+				ProjectName:      util.Ptr("staging"),
+				State:            management.WorkspaceGroupStateACTIVE,
+			},
+			{
+				WorkspaceGroupID: terminatedGroupID,
+				Name:             "old-group",
+				ProjectName:      util.Ptr("analytics"),
+				State:            management.WorkspaceGroupStateTERMINATED,
+			},
+			{
+				WorkspaceGroupID: uuid.MustParse("7e0f6da7-bf11-42dc-8b57-31e77140fbf3"),
+				Name:             "team/group",
+				State:            management.WorkspaceGroupStateACTIVE,
+			},
+		},
+	}
+
+	t.Run("UUID passes through without listing", func(t *testing.T) {
+		id, serr := resolveImportID(t.Context(), client, analyticsGroupID.String())
+		require.Nil(t, serr)
+		require.Equal(t, analyticsGroupID.String(), id)
+		require.Zero(t, client.listCalls)
+	})
+
+	t.Run("project and group name", func(t *testing.T) {
+		id, serr := resolveImportID(t.Context(), client, "Staging/Group")
+		require.Nil(t, serr)
+		require.Equal(t, stagingGroupID.String(), id)
+	})
+
+	t.Run("name prefix keeps slashes in the name", func(t *testing.T) {
+		id, serr := resolveImportID(t.Context(), client, "name:team/group")
+		require.Nil(t, serr)
+		require.Equal(t, "7e0f6da7-bf11-42dc-8b57-31e77140fbf3", id)
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		_, serr := resolveImportID(t.Context(), client, "name:group")
+		require.NotNil(t, serr)
+		require.Equal(t, "Multiple workspace groups found", serr.Summary)
+		require.Contains(t, serr.Detail, analyticsGroupID.String())
+		require.Contains(t, serr.Detail, stagingGroupID.String())
+	})
+
+	t.Run("terminated groups are ignored", func(t *testing.T) {
+		_, serr := resolveImportID(t.Context(), client, "analytics/old-group")
+		require.NotNil(t, serr)
+		require.Equal(t, "Workspace group not found", serr.Summary)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		for _, importID := range []string{"", "group", "name:", "analytics/"} {
+			_, serr := resolveImportID(t.Context(), client, importID)
+			require.NotNil(t, serr, importID)
+			require.Equal(t, "Invalid import ID", serr.Summary, importID)
+		}
+	})
+}
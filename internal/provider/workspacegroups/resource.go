@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -22,7 +23,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
@@ -266,23 +266,29 @@ func (r *workspaceGroupResource) Create(ctx context.Context, req resource.Create
 	}
 
 	id := workspaceGroupCreateResponse.JSON200.WorkspaceGroupID
+	adminPassword := util.FirstNotEmpty(
+		plan.AdminPassword.ValueString(),
+		util.Deref(workspaceGroupCreateResponse.JSON200.AdminPassword), // Either from input or output.
+	)
+
+	resp.Diagnostics.Append(util.SavePendingCreate(ctx, req, resp, id.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("admin_password"), adminPassword)...) // The generated password is only returned once.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	wg, werr := verifyStatusAndGetWorkspaceGroup(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupCreationTimeout, waitConditionFirewallRanges(plan.FirewallRanges))
 	if werr != nil {
-		resp.Diagnostics.AddError(
-			werr.Summary,
-			werr.Detail,
-		)
+		util.AddCreateWaitDiagnostic(ctx, &resp.Diagnostics, "workspace group", id.String(), werr)
 
 		return
 	}
 
-	result := toWorkspaceGroupResourceModel(wg, util.FirstNotEmpty(
-		plan.AdminPassword.ValueString(),
-		util.Deref(workspaceGroupCreateResponse.JSON200.AdminPassword), // Either from input or output.
-	), regionIDIsSet, plan.FirewallRanges)
+	result := toWorkspaceGroupResourceModel(wg, adminPassword, regionIDIsSet, plan.FirewallRanges)
 
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
 }
 
 func validateRequiredRegionParameters(plan *workspaceGroupResourceModel) *util.SummaryWithDetailError {
@@ -370,6 +376,18 @@ func workspaceGroupPatchAdminPassword(plan, state workspaceGroupResourceModel) *
 	return util.MaybeNonEmptyString(plan.AdminPassword)
 }
 
+// workspaceGroupUpdateNeeded reports whether the plan differs from the workspace group by any attribute that the update changes.
+func workspaceGroupUpdateNeeded(plan, state workspaceGroupResourceModel, wg management.WorkspaceGroup, regionIDIsSet bool) bool {
+	current := toWorkspaceGroupResourceModel(wg, state.AdminPassword.ValueString(), regionIDIsSet, plan.FirewallRanges)
+
+	return workspaceGroupPatchAdminPassword(plan, state) != nil ||
+		!plan.Name.Equal(current.Name) ||
+		(util.IsConfiguredString(plan.ExpiresAt) && !plan.ExpiresAt.Equal(current.ExpiresAt)) ||
+		!firewallRangesConverged(plan.FirewallRanges, wg) ||
+		(util.IsConfiguredString(plan.DeploymentType) && !plan.DeploymentType.Equal(current.DeploymentType)) ||
+		(!plan.UpdateWindow.IsNull() && !plan.UpdateWindow.IsUnknown() && !plan.UpdateWindow.Equal(current.UpdateWindow))
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *workspaceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan workspaceGroupResourceModel
@@ -387,36 +405,61 @@ func (r *workspaceGroupResource) Update(ctx context.Context, req resource.Update
 	}
 
 	id := uuid.MustParse(plan.ID.ValueString())
-	workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
-		management.WorkspaceGroupUpdate{
-			AdminPassword:  workspaceGroupPatchAdminPassword(plan, state),
-			ExpiresAt:      util.MaybeString(plan.ExpiresAt),
-			Name:           util.MaybeString(plan.Name),
-			FirewallRanges: util.Ptr(util.StringFirewallRanges(plan.FirewallRanges)),
-			DeploymentType: util.WorkspaceGroupUpdateDeploymentTypeString(plan.DeploymentType),
-			UpdateWindow:   toManagementUpdateWindow(ctx, plan.UpdateWindow),
-		},
-	)
-	if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
-		resp.Diagnostics.AddError(
-			serr.Summary,
-			serr.Detail,
-		)
+	regionIDIsSet := util.IsConfiguredString(plan.RegionID)
 
-		return
+	var wg management.WorkspaceGroup
+	updateNeeded := true
+
+	if util.IsCreatePending(ctx, req.Private) {
+		var werr *util.SummaryWithDetailError
+		wg, werr = verifyStatusAndGetWorkspaceGroup(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupCreationTimeout, waitConditionFirewallRanges(state.FirewallRanges))
+		if werr != nil {
+			resp.Diagnostics.AddError(
+				werr.Summary,
+				werr.Detail,
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
+
+		// The plan of a pending creation usually only differs by the values that were unknown until the group was ready.
+		updateNeeded = workspaceGroupUpdateNeeded(plan, state, wg, regionIDIsSet)
 	}
 
-	wg, werr := verifyStatusAndGetWorkspaceGroup(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupUpdateTimeout, waitConditionFirewallRanges(plan.FirewallRanges))
-	if werr != nil {
-		resp.Diagnostics.AddError(
-			werr.Summary,
-			werr.Detail,
+	if updateNeeded {
+		workspaceGroupUpdateResponse, err := r.PatchV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id,
+			management.WorkspaceGroupUpdate{
+				AdminPassword:  workspaceGroupPatchAdminPassword(plan, state),
+				ExpiresAt:      util.MaybeString(plan.ExpiresAt),
+				Name:           util.MaybeString(plan.Name),
+				FirewallRanges: util.Ptr(util.StringFirewallRanges(plan.FirewallRanges)),
+				DeploymentType: util.WorkspaceGroupUpdateDeploymentTypeString(plan.DeploymentType),
+				UpdateWindow:   toManagementUpdateWindow(ctx, plan.UpdateWindow),
+			},
 		)
+		if serr := util.StatusOK(workspaceGroupUpdateResponse, err); serr != nil {
+			resp.Diagnostics.AddError(
+				serr.Summary,
+				serr.Detail,
+			)
 
-		return
+			return
+		}
+
+		var werr *util.SummaryWithDetailError
+		wg, werr = verifyStatusAndGetWorkspaceGroup(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceGroupUpdateTimeout, waitConditionFirewallRanges(plan.FirewallRanges))
+		if werr != nil {
+			resp.Diagnostics.AddError(
+				werr.Summary,
+				werr.Detail,
+			)
+
+			return
+		}
 	}
 
-	regionIDIsSet := util.IsConfiguredString(plan.RegionID)
 	result := toWorkspaceGroupResourceModel(wg, plan.AdminPassword.ValueString(), regionIDIsSet, plan.FirewallRanges)
 
 	diags = resp.State.Set(ctx, &result)
@@ -492,6 +535,8 @@ func (r *workspaceGroupResource) ModifyPlan(ctx context.Context, req resource.Mo
 
 		return
 	}
+
+	util.PlanPendingCreate(ctx, req, resp, "created_at", "outbound_allow_list")
 }
 
 func validateModifyPlanRegionParameters(ctx context.Context, r *workspaceGroupResource, plan, state *workspaceGroupResourceModel) *util.SummaryWithDetailError {
@@ -619,8 +664,17 @@ func validateModifyImmutableWorkspaceGroupFlags(plan, state *workspaceGroupResou
 }
 
 // ImportState results in Terraform managing the resource that was not previously managed.
+//
+// Besides the workspace group ID, the import ID may be "name:<group>" or "<project>/<group>".
 func (r *workspaceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	util.ImportStatePassthroughID(ctx, req, resp)
+	id, serr := resolveImportID(ctx, r.ClientWithResponsesInterface, req.ID)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(config.IDAttribute), id)...)
 }
 
 // toWorkspaceGroupResourceModel maps a workspace group onto the resource model.
@@ -709,62 +763,58 @@ func normalizeCloudProvider(provider management.CloudProvider) basetypes.StringV
 }
 
 // waitCondition return nil if it is satisfied.
-type waitCondition func(management.WorkspaceGroup) error
+type waitCondition = util.PollCondition[management.WorkspaceGroup]
 
 func verifyStatusAndGetWorkspaceGroup(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceGroupID, timeout time.Duration, conditions ...waitCondition) (management.WorkspaceGroup, *util.SummaryWithDetailError) {
-	result := management.WorkspaceGroup{}
-
-	workspaceGroupStateHistory := make([]management.WorkspaceGroupState, 0, config.WorkspaceGroupConsistencyThreshold)
-
-	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		workspaceGroup, err := c.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
-		if err != nil { // Not status code OK does not get here, not retrying for that reason.
-			ferr := fmt.Errorf("failed to get workspace group %s: %w", id, err)
-
-			return retry.NonRetryableError(ferr)
-		}
-
-		if code := workspaceGroup.StatusCode(); code != http.StatusOK {
-			err := fmt.Errorf("failed to get workspace group %s: status code %s", id, http.StatusText(code))
-
-			return retry.RetryableError(err)
-		}
-
-		workspaceGroupStateHistory = append(workspaceGroupStateHistory, workspaceGroup.JSON200.State)
-
-		if isFatalWorkspaceGroupState(workspaceGroup.JSON200.State) {
-			err := fmt.Errorf("workspace group %s create or update failed; %s", workspaceGroup.JSON200.WorkspaceGroupID, config.ContactSupportErrorDetail)
-
-			return retry.NonRetryableError(err)
-		}
-
-		if !util.CheckLastN(workspaceGroupStateHistory, config.WorkspaceGroupConsistencyThreshold, management.WorkspaceGroupStateACTIVE, management.WorkspaceGroupStatePENDING) {
-			err = fmt.Errorf("workspace group %s state is %s but the Management API did not return the same state for the consequent %d iterations yet",
-				id, workspaceGroup.JSON200.State, config.WorkspaceGroupConsistencyThreshold,
-			)
-
-			return retry.RetryableError(err)
-		}
+	result, err := util.Poller[management.WorkspaceGroup]{
+		Description:       fmt.Sprintf("workspace group %s", id),
+		Timeout:           timeout,
+		ConsistencyWindow: config.WorkspaceGroupConsistencyThreshold,
+		Get: func(ctx context.Context) (management.WorkspaceGroup, error) {
+			workspaceGroup, err := c.GetV1WorkspaceGroupsWorkspaceGroupIDWithResponse(ctx, id, &management.GetV1WorkspaceGroupsWorkspaceGroupIDParams{})
+			if err != nil { // Not status code OK does not get here, not retrying for that reason.
+				return management.WorkspaceGroup{}, util.StopPolling(fmt.Errorf("failed to get workspace group %s: %w", id, err))
+			}
 
-		for _, c := range conditions {
-			if err := c(*workspaceGroup.JSON200); err != nil {
-				return retry.RetryableError(err)
+			if code := workspaceGroup.StatusCode(); code != http.StatusOK {
+				return management.WorkspaceGroup{}, fmt.Errorf("failed to get workspace group %s: status code %s", id, http.StatusText(code))
 			}
-		}
 
-		result = *workspaceGroup.JSON200
+			return *workspaceGroup.JSON200, nil
+		},
+		Terminal: func(wg management.WorkspaceGroup) *util.TerminalStateError {
+			if !isFatalWorkspaceGroupState(wg.State) {
+				return nil
+			}
 
-		return nil
-	}); err != nil {
+			return &util.TerminalStateError{
+				State: string(wg.State),
+				Err:   fmt.Errorf("workspace group %s create or update failed; %s", wg.WorkspaceGroupID, config.ContactSupportErrorDetail),
+			}
+		},
+		Conditions: append([]waitCondition{waitConditionState(management.WorkspaceGroupStateACTIVE, management.WorkspaceGroupStatePENDING)}, conditions...),
+	}.Poll(ctx)
+	if err != nil {
 		return management.WorkspaceGroup{}, &util.SummaryWithDetailError{
 			Summary: fmt.Sprintf("Failed to wait for a workspace group %s to be ready", id),
 			Detail:  fmt.Sprintf("Workspace group is not ready: %s", err),
+			Err:     err,
 		}
 	}
 
 	return result, nil
 }
 
+func waitConditionState(states ...management.WorkspaceGroupState) waitCondition {
+	return func(wg management.WorkspaceGroup) error {
+		if !util.Any(states, wg.State) {
+			return fmt.Errorf("workspace group %s state is %s, but should be %s", wg.WorkspaceGroupID, wg.State, util.Join(states, ", "))
+		}
+
+		return nil
+	}
+}
+
 func isFatalWorkspaceGroupState(state management.WorkspaceGroupState) bool {
 	return state == management.WorkspaceGroupStateFAILED || state == management.WorkspaceGroupStateTERMINATED
 }
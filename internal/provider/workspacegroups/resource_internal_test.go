@@ -123,3 +123,41 @@ func TestToUpdateWindowResourceModel(t *testing.T) {
 		require.Equal(t, int64(5), model.Day.ValueInt64())
 	})
 }
+
+func TestWorkspaceGroupUpdateNeeded(t *testing.T) {
+	wg := management.WorkspaceGroup{
+		Name:           "group",
+		FirewallRanges: &[]string{"10.0.0.0/8"},
+	}
+	state := workspaceGroupResourceModel{
+		Name:           types.StringValue("group"),
+		AdminPassword:  types.StringValue("secret-password-123!"),
+		FirewallRanges: []types.String{types.StringValue("10.0.0.0/8")},
+		UpdateWindow:   types.ObjectNull(map[string]attr.Type{"hour": types.Int64Type, "day": types.Int64Type}),
+	}
+
+	t.Run("created as planned", func(t *testing.T) {
+		plan := state
+		plan.ExpiresAt = types.StringUnknown()
+		plan.DeploymentType = types.StringNull()
+		require.False(t, workspaceGroupUpdateNeeded(plan, state, wg, false))
+	})
+
+	t.Run("renamed", func(t *testing.T) {
+		plan := state
+		plan.Name = types.StringValue("renamed")
+		require.True(t, workspaceGroupUpdateNeeded(plan, state, wg, false))
+	})
+
+	t.Run("firewall ranges changed", func(t *testing.T) {
+		plan := state
+		plan.FirewallRanges = []types.String{types.StringValue("192.168.0.0/16")}
+		require.True(t, workspaceGroupUpdateNeeded(plan, state, wg, false))
+	})
+
+	t.Run("admin password changed", func(t *testing.T) {
+		plan := state
+		plan.AdminPassword = types.StringValue("another-password-123!")
+		require.True(t, workspaceGroupUpdateNeeded(plan, state, wg, false))
+	})
+}
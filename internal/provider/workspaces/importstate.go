@@ -0,0 +1,85 @@
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
+)
+
+// resolveImportID maps an import ID onto the workspace ID.
+//
+// The import ID is either the workspace UUID or "<workspace group name>/<workspace name>".
+// Names are resolved through the list endpoints; terminated workspace groups and workspaces are ignored.
+func resolveImportID(ctx context.Context, c management.ClientWithResponsesInterface, importID string) (string, *util.SummaryWithDetailError) {
+	if _, err := uuid.Parse(importID); err == nil {
+		return importID, nil
+	}
+
+	groupName, workspaceName, ok := strings.Cut(importID, "/")
+	if !ok || strings.TrimSpace(groupName) == "" || strings.TrimSpace(workspaceName) == "" {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Invalid import ID",
+			Detail:  fmt.Sprintf("The provided import ID \"%s\" is neither a valid UUID, nor in the form \"<workspace group name>/<workspace name>\".", importID),
+		}
+	}
+
+	workspaceGroups, err := c.GetV1WorkspaceGroupsWithResponse(ctx, &management.GetV1WorkspaceGroupsParams{})
+	if serr := util.StatusOK(workspaceGroups, err); serr != nil {
+		return "", serr
+	}
+
+	groups := util.Filter(util.Deref(workspaceGroups.JSON200), func(wg management.WorkspaceGroup) bool {
+		return wg.State != management.WorkspaceGroupStateTERMINATED && namesEqual(wg.Name, groupName)
+	})
+
+	if len(groups) == 0 {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Workspace group not found",
+			Detail:  fmt.Sprintf("No workspace group with the name '%s' was found. Please verify that the name is correct and that the workspace group exists.", groupName),
+		}
+	}
+
+	if len(groups) > 1 {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Multiple workspace groups found",
+			Detail: fmt.Sprintf("Multiple workspace groups with the name '%s' were found: %s. Please import by the workspace ID instead.",
+				groupName, util.Join(util.Map(groups, func(wg management.WorkspaceGroup) string { return wg.WorkspaceGroupID.String() }), ", ")),
+		}
+	}
+
+	workspaces, err := c.GetV1WorkspacesWithResponse(ctx, &management.GetV1WorkspacesParams{
+		WorkspaceGroupID: groups[0].WorkspaceGroupID,
+	})
+	if serr := util.StatusOK(workspaces, err); serr != nil {
+		return "", serr
+	}
+
+	result := util.Filter(util.Deref(workspaces.JSON200), func(w management.Workspace) bool {
+		return w.State != management.WorkspaceStateTERMINATED && namesEqual(w.Name, workspaceName)
+	})
+
+	if len(result) == 0 {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Workspace not found",
+			Detail:  fmt.Sprintf("No workspace with the name '%s' was found in the workspace group '%s'. Please verify that the name is correct and that the workspace exists.", workspaceName, groupName),
+		}
+	}
+
+	if len(result) > 1 {
+		return "", &util.SummaryWithDetailError{
+			Summary: "Multiple workspaces found",
+			Detail: fmt.Sprintf("Multiple workspaces with the name '%s' were found in the workspace group '%s': %s. Please import by the workspace ID instead.",
+				workspaceName, groupName, util.Join(util.Map(result, func(w management.Workspace) string { return w.WorkspaceID.String() }), ", ")),
+		}
+	}
+
+	return result[0].WorkspaceID.String(), nil
+}
+
+func namesEqual(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
@@ -0,0 +1,89 @@
+package workspaces
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/singlestore-labs/singlestore-go/management"
+	"github.com/stretchr/testify/require"
+)
+
+type importMockClient struct {
+	management.ClientWithResponsesInterface
+	workspaceGroups []management.WorkspaceGroup
+	workspaces      []management.Workspace
+}
+
+func (m *importMockClient) GetV1WorkspaceGroupsWithResponse(_ context.Context, _ *management.GetV1WorkspaceGroupsParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspaceGroupsResponse, error) {
+	return &management.GetV1WorkspaceGroupsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200:      &m.workspaceGroups,
+	}, nil
+}
+
+func (m *importMockClient) GetV1WorkspacesWithResponse(_ context.Context, params *management.GetV1WorkspacesParams, _ ...management.RequestEditorFn) (*management.GetV1WorkspacesResponse, error) {
+	var result []management.Workspace
+	for _, w := range m.workspaces {
+		if w.WorkspaceGroupID == params.WorkspaceGroupID {
+			result = append(result, w)
+		}
+	}
+
+	return &management.GetV1WorkspacesResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200:      &result,
+	}, nil
+}
+
+func TestResolveImportID(t *testing.T) {
+	groupID := uuid.MustParse("e1a0a960-8591-4196-bb26-f53f0f8e35ce")
+	duplicateGroupID := uuid.MustParse("3c0c0d99-3c09-45ac-a01f-5ab62afd35cf")
+	workspaceID := uuid.MustParse("26171125-ecb8-5944-9896-209fbffc1f15")
+
+	client := &importMockClient{
+		workspaceGroups: []management.WorkspaceGroup{
+			{WorkspaceGroupID: groupID, Name: "group", State: management.WorkspaceGroupStateACTIVE},
+			{WorkspaceGroupID: duplicateGroupID, Name: "shared", State: management.WorkspaceGroupStateACTIVE},
+			{WorkspaceGroupID: uuid.New(), Name: "shared", State: management.WorkspaceGroupStateACTIVE},
+		},
+		workspaces: []management.Workspace{
+			{WorkspaceID: workspaceID, WorkspaceGroupID: groupID, Name: "workspace-1", State: management.WorkspaceStateACTIVE},
+			{WorkspaceID: uuid.New(), WorkspaceGroupID: groupID, Name: "workspace-2", State: management.WorkspaceStateTERMINATED},
+			{WorkspaceID: uuid.New(), WorkspaceGroupID: duplicateGroupID, Name: "workspace-1", State: management.WorkspaceStateACTIVE},
+		},
+	}
+
+	t.Run("UUID passes through", func(t *testing.T) {
+		id, serr := resolveImportID(t.Context(), client, workspaceID.String())
+		require.Nil(t, serr)
+		require.Equal(t, workspaceID.String(), id)
+	})
+
+	t.Run("group and workspace name", func(t *testing.T) {
+		id, serr := resolveImportID(t.Context(), client, "Group/Workspace-1")
+		require.Nil(t, serr)
+		require.Equal(t, workspaceID.String(), id)
+	})
+
+	t.Run("ambiguous workspace group", func(t *testing.T) {
+		_, serr := resolveImportID(t.Context(), client, "shared/workspace-1")
+		require.NotNil(t, serr)
+		require.Equal(t, "Multiple workspace groups found", serr.Summary)
+	})
+
+	t.Run("terminated workspaces are ignored", func(t *testing.T) {
+		_, serr := resolveImportID(t.Context(), client, "group/workspace-2")
+		require.NotNil(t, serr)
+		require.Equal(t, "Workspace not found", serr.Summary)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		for _, importID := range []string{"", "workspace-1", "group/", "/workspace-1"} {
+			_, serr := resolveImportID(t.Context(), client, importID)
+			require.NotNil(t, serr, importID)
+			require.Equal(t, "Invalid import ID", serr.Summary, importID)
+		}
+	})
+}
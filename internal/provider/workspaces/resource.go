@@ -258,6 +258,17 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	unlock, lerr := util.LockWorkspaceGroup(ctx, plan.WorkspaceGroupID.ValueString(), fmt.Sprintf("Creating the workspace %s", plan.Name.ValueString()))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
+
 	workspaceCreateResponse, err := r.PostV1WorkspacesWithResponse(ctx, management.PostV1WorkspacesJSONRequestBody{
 		Name:             plan.Name.ValueString(),
 		Size:             util.MaybeString(plan.Size),
@@ -277,14 +288,17 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	w, werr := wait(ctx, r.ClientWithResponsesInterface, workspaceCreateResponse.JSON200.WorkspaceID, config.WorkspaceCreationTimeout,
+	id := workspaceCreateResponse.JSON200.WorkspaceID
+	resp.Diagnostics.Append(util.SavePendingCreate(ctx, req, resp, id.String())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	w, werr := wait(ctx, r.ClientWithResponsesInterface, id, config.WorkspaceCreationTimeout,
 		waitConditionState(management.WorkspaceStateACTIVE),
 	)
 	if werr != nil {
-		resp.Diagnostics.AddError(
-			werr.Summary,
-			werr.Detail,
-		)
+		util.AddCreateWaitDiagnostic(ctx, &resp.Diagnostics, "workspace", id.String(), werr)
 
 		return
 	}
@@ -292,6 +306,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	result := toWorkspaceResourceModel(w)
 	diags = resp.State.Set(ctx, &result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -323,8 +338,10 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return // The resource got terminated externally, deleting it from the state file to recreate.
 	}
 
+	createPending := util.IsCreatePending(ctx, req.Private) && workspace.JSON200.State == management.WorkspaceStatePENDING
+
 	if workspace.JSON200.State != management.WorkspaceStateACTIVE &&
-		workspace.JSON200.State != management.WorkspaceStateSUSPENDED {
+		workspace.JSON200.State != management.WorkspaceStateSUSPENDED && !createPending {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Workspace %s state is %s while it should be %s or %s", state.ID.ValueString(), workspace.JSON200.State, management.WorkspaceStateACTIVE, management.WorkspaceStateSUSPENDED),
 			"An unexpected workspace state.\n\n"+
@@ -358,6 +375,34 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	unlock, lerr := util.LockWorkspaceGroup(ctx, state.WorkspaceGroupID.ValueString(), fmt.Sprintf("Updating the workspace %s", state.ID.ValueString()))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
+
+	if util.IsCreatePending(ctx, req.Private) {
+		w, werr := wait(ctx, r.ClientWithResponsesInterface, uuid.MustParse(state.ID.ValueString()), config.WorkspaceCreationTimeout,
+			waitConditionState(management.WorkspaceStateACTIVE),
+		)
+		if werr != nil {
+			resp.Diagnostics.AddError(
+				werr.Summary,
+				werr.Detail,
+			)
+
+			return
+		}
+
+		state = toWorkspaceResourceModel(w)
+		resp.Diagnostics.Append(util.ClearCreatePending(ctx, resp.Private)...)
+	}
+
 	var uerr *util.SummaryWithDetailError
 	state, uerr = applyWorkspaceConfigOrToggleSuspension(ctx, r.ClientWithResponsesInterface, state, plan)
 	if uerr != nil {
@@ -385,6 +430,17 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	unlock, lerr := util.LockWorkspaceGroup(ctx, state.WorkspaceGroupID.ValueString(), fmt.Sprintf("Deleting the workspace %s", state.ID.ValueString()))
+	if lerr != nil {
+		resp.Diagnostics.AddError(
+			lerr.Summary,
+			lerr.Detail,
+		)
+
+		return
+	}
+	defer unlock()
+
 	workspaceDeleteResponse, err := r.DeleteV1WorkspacesWorkspaceIDWithResponse(ctx, uuid.MustParse(state.ID.ValueString()))
 	if serr := util.StatusOK(workspaceDeleteResponse, err, util.ReturnNilOnNotFound); serr != nil {
 		resp.Diagnostics.AddError(
@@ -412,14 +468,20 @@ func (r *workspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 	var state *workspaceResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || state == nil {
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	var plan *workspaceResourceModel
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() || plan == nil {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planWorkspaceGroupOperation(ctx, req, plan, state)
+
+	if state == nil || plan == nil {
 		return
 	}
 
@@ -454,11 +516,35 @@ func (r *workspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 
 		return
 	}
+
+	util.PlanPendingCreate(ctx, req, resp, "created_at", "endpoint")
+}
+
+// planWorkspaceGroupOperation logs at plan time when the planned create, update, or delete will wait
+// for another operation in the workspace group during apply.
+func planWorkspaceGroupOperation(ctx context.Context, req resource.ModifyPlanRequest, plan, state *workspaceResourceModel) {
+	switch {
+	case state == nil && plan != nil:
+		util.PlanWorkspaceGroupOperation(ctx, plan.WorkspaceGroupID, fmt.Sprintf("Creating the workspace %s", plan.Name.ValueString()))
+	case plan == nil && state != nil:
+		util.PlanWorkspaceGroupOperation(ctx, state.WorkspaceGroupID, fmt.Sprintf("Deleting the workspace %s", state.ID.ValueString()))
+	case plan != nil && !req.Plan.Raw.Equal(req.State.Raw):
+		util.PlanWorkspaceGroupOperation(ctx, state.WorkspaceGroupID, fmt.Sprintf("Updating the workspace %s", state.ID.ValueString()))
+	}
 }
 
 // ImportState results in Terraform managing the resource that was not previously managed.
+//
+// Besides the workspace ID, the import ID may be "<workspace group name>/<workspace name>".
 func (r *workspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	util.ImportStatePassthroughID(ctx, req, resp)
+	id, serr := resolveImportID(ctx, r.ClientWithResponsesInterface, req.ID)
+	if serr != nil {
+		resp.Diagnostics.AddError(serr.Summary, serr.Detail)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(config.IDAttribute), id)...)
 }
 
 func toWorkspaceResourceModel(workspace management.Workspace) workspaceResourceModel {
@@ -6,51 +6,48 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/singlestore-labs/singlestore-go/management"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/config"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/util"
 )
 
 // waitCondition return nil if it is satisfied.
-type waitCondition func(management.Workspace) error
+type waitCondition = util.PollCondition[management.Workspace]
 
 func wait(ctx context.Context, c management.ClientWithResponsesInterface, id management.WorkspaceID, timeout time.Duration, conditions ...waitCondition) (management.Workspace, *util.SummaryWithDetailError) {
-	result := management.Workspace{}
-
-	if err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
-		workspace, err := c.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
-		if err != nil { // Not status code OK does not get here, not retrying for that reason.
-			ferr := fmt.Errorf("failed to get workspace %s: %w", id, err)
-
-			return retry.NonRetryableError(ferr)
-		}
-
-		if code := workspace.StatusCode(); code != http.StatusOK {
-			err := fmt.Errorf("failed to get workspace %s: status code %s", id, http.StatusText(code))
-
-			return retry.RetryableError(err)
-		}
-
-		if workspace.JSON200.State == management.WorkspaceStateFAILED {
-			err := fmt.Errorf("workspace %s failed; %s", workspace.JSON200.WorkspaceID, config.ContactSupportErrorDetail)
-
-			return retry.NonRetryableError(err)
-		}
+	result, err := util.Poller[management.Workspace]{
+		Description:       fmt.Sprintf("workspace %s", id),
+		Timeout:           timeout,
+		ConsistencyWindow: config.WorkspaceConsistencyThreshold,
+		Get: func(ctx context.Context) (management.Workspace, error) {
+			workspace, err := c.GetV1WorkspacesWorkspaceIDWithResponse(ctx, id, &management.GetV1WorkspacesWorkspaceIDParams{})
+			if err != nil { // Not status code OK does not get here, not retrying for that reason.
+				return management.Workspace{}, util.StopPolling(fmt.Errorf("failed to get workspace %s: %w", id, err))
+			}
 
-		for _, c := range conditions {
-			if err := c(*workspace.JSON200); err != nil {
-				return retry.RetryableError(err)
+			if code := workspace.StatusCode(); code != http.StatusOK {
+				return management.Workspace{}, fmt.Errorf("failed to get workspace %s: status code %s", id, http.StatusText(code))
 			}
-		}
 
-		result = *workspace.JSON200
+			return *workspace.JSON200, nil
+		},
+		Terminal: func(w management.Workspace) *util.TerminalStateError {
+			if w.State != management.WorkspaceStateFAILED {
+				return nil
+			}
 
-		return nil
-	}); err != nil {
-		return result, &util.SummaryWithDetailError{
+			return &util.TerminalStateError{
+				State: string(w.State),
+				Err:   fmt.Errorf("workspace %s failed; %s", w.WorkspaceID, config.ContactSupportErrorDetail),
+			}
+		},
+		Conditions: conditions,
+	}.Poll(ctx)
+	if err != nil {
+		return management.Workspace{}, &util.SummaryWithDetailError{
 			Summary: fmt.Sprintf("Failed to wait for a workspace %s creation", id),
 			Detail:  fmt.Sprintf("Workspace is not ready: %s", err.Error()),
+			Err:     err,
 		}
 	}
 
@@ -58,21 +55,11 @@ func wait(ctx context.Context, c management.ClientWithResponsesInterface, id man
 }
 
 func waitConditionState(states ...management.WorkspaceState) func(management.Workspace) error {
-	workspaceStateHistory := make([]management.WorkspaceState, 0, config.WorkspaceConsistencyThreshold)
-
 	return func(w management.Workspace) error {
-		workspaceStateHistory = append(workspaceStateHistory, w.State)
-
 		if !util.Any(states, w.State) {
 			return fmt.Errorf("workspace %s state is %s, but should be %s", w.WorkspaceID, w.State, util.Join(states, ", "))
 		}
 
-		if !util.CheckLastN(workspaceStateHistory, config.WorkspaceConsistencyThreshold, states...) {
-			return fmt.Errorf("workspace %s state is %s but the Management API did not return the same state for the consequent %d iterations yet",
-				w.WorkspaceID, w.State, config.WorkspaceConsistencyThreshold,
-			)
-		}
-
 		return nil
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider"
+	singlestoresql "github.com/singlestore-labs/terraform-provider-singlestoredb/internal/provider/sql"
 )
 
 var version = "dev" // Version is populated by goreleaser with ldflags.
@@ -24,7 +25,10 @@ func main() {
 		Debug:   debug,
 	}
 
-	if err := providerserver.Serve(ctx, provider.New(version), opts); err != nil {
+	err := providerserver.Serve(ctx, provider.New(version), opts)
+	singlestoresql.CloseMySQLPools()
+
+	if err != nil {
 		log.Fatal(err)
 	}
 }